@@ -1,7 +1,6 @@
 package sflow
 
 import (
-	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -49,6 +48,30 @@ func (s *CounterSample) GetRecords() []records.Record {
 	return s.Records
 }
 
+// HostParent returns the host_parent record of the sample, if present.
+// Physical hosts do not report a parent.
+func (s *CounterSample) HostParent() (records.HostParent, bool) {
+	return hostParent(s.Records)
+}
+
+// IsVirtual reports whether the source ID of the sample identifies a virtual
+// machine, i.e. the sample carries a host_parent record pointing to its hypervisor.
+func (s *CounterSample) IsVirtual() bool {
+	_, ok := hostParent(s.Records)
+	return ok
+}
+
+// hostParent returns the first host_parent record of recs.
+func hostParent(recs []records.Record) (records.HostParent, bool) {
+	for _, rec := range recs {
+		if parent, ok := rec.(records.HostParent); ok {
+			return parent, true
+		}
+	}
+
+	return records.HostParent{}, false
+}
+
 func decodeCounterSample(r io.ReadSeeker) (Sample, error) {
 	s := &CounterSample{}
 
@@ -75,18 +98,71 @@ func decodeCounterSample(r io.ReadSeeker) (Sample, error) {
 	}
 
 	s.SourceIdIndexVal = uint32(srcIdIndexVal[2]) |
-		uint32(srcIdIndexVal[1]<<8) |
-		uint32(srcIdIndexVal[0]<<16)
+		uint32(srcIdIndexVal[1])<<8 |
+		uint32(srcIdIndexVal[0])<<16
 
 	err = binary.Read(r, binary.BigEndian, &s.numRecords)
 	if err != nil {
 		return nil, err
 	}
 
-	for i := uint32(0); i < s.numRecords; i++ {
+	s.Records, err = decodeCounterRecords(r, s.numRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *CounterSample) encode(w io.Writer) error {
+	// We first need to encode the records.
+	buf, err := encodeRecords(s.Records)
+	if err != nil {
+		return err
+	}
+
+	// Fields
+	encodedSampleSize := uint32(4 + 1 + 3 + 4)
+
+	// Encoded records
+	encodedSampleSize += uint32(buf.Len())
+
+	err = binary.Write(w, binary.BigEndian, uint32(s.SampleType()))
+	if err != nil {
+		return err
+	}
+	err = binary.Write(w, binary.BigEndian, encodedSampleSize)
+	if err != nil {
+		return err
+	}
+	err = binary.Write(w, binary.BigEndian, s.SequenceNum)
+	if err != nil {
+		return err
+	}
+	err = binary.Write(w, binary.BigEndian,
+		uint32(s.SourceIdType)<<24|s.SourceIdIndexVal&0xffffff)
+	if err != nil {
+		return err
+	}
+	err = binary.Write(w, binary.BigEndian,
+		uint32(len(s.Records)))
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, buf)
+	return err
+}
+
+// decodeCounterRecords decodes numRecords counter records from r.
+// Records of unknown types are skipped.
+func decodeCounterRecords(r io.ReadSeeker, numRecords uint32) ([]records.Record, error) {
+	var recs []records.Record
+
+	for i := uint32(0); i < numRecords; i++ {
 		format, length := uint32(0), uint32(0)
 
-		err = binary.Read(r, binary.BigEndian, &format)
+		err := binary.Read(r, binary.BigEndian, &format)
 		if err != nil {
 			return nil, err
 		}
@@ -154,63 +230,24 @@ func decodeCounterSample(r io.ReadSeeker) (Sample, error) {
 				return nil, err
 			}
 		default:
-			if rec, err = records.DecodeCounter(r, format); err != nil {
-				fmt.Printf("Error: %s\n", err)
-				_, err := r.Seek(int64(length), 1)
-				if err != nil {
-					return nil, err
-				}
-				continue
-			}
-		}
+			// The record must not read past its own length
+			lr := &io.LimitedReader{R: r, N: int64(length)}
 
-		s.Records = append(s.Records, rec)
-	}
-	return s, nil
-}
+			rec, err = records.DecodeCounter(lr, format)
 
-func (s *CounterSample) encode(w io.Writer) error {
-	var err error
-
-	// We first need to encode the records.
-	buf := &bytes.Buffer{}
+			// Skip unknown records and anything the decoder did not consume
+			if _, err := io.Copy(io.Discard, lr); err != nil {
+				return nil, err
+			}
 
-	for _, rec := range s.Records {
-		err = rec.Encode(buf)
-		if err != nil {
-			return records.ErrEncodingRecord
+			// Records that fail to decode are skipped like unknown ones
+			if err != nil {
+				continue
+			}
 		}
-	}
-
-	// Fields
-	encodedSampleSize := uint32(4 + 1 + 3 + 4)
 
-	// Encoded records
-	encodedSampleSize += uint32(buf.Len())
-
-	err = binary.Write(w, binary.BigEndian, uint32(s.SampleType()))
-	if err != nil {
-		return err
-	}
-	err = binary.Write(w, binary.BigEndian, encodedSampleSize)
-	if err != nil {
-		return err
-	}
-	err = binary.Write(w, binary.BigEndian, s.SequenceNum)
-	if err != nil {
-		return err
-	}
-	err = binary.Write(w, binary.BigEndian,
-		uint32(s.SourceIdType)|s.SourceIdIndexVal<<24)
-	if err != nil {
-		return err
-	}
-	err = binary.Write(w, binary.BigEndian,
-		uint32(len(s.Records)))
-	if err != nil {
-		return err
+		recs = append(recs, rec)
 	}
 
-	_, err = io.Copy(w, buf)
-	return err
+	return recs, nil
 }
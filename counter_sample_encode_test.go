@@ -2,7 +2,10 @@ package sflow
 
 import (
 	"bytes"
+	"encoding/binary"
+	"github.com/yseto/sflow/records"
 	"os"
+	"reflect"
 	"testing"
 )
 
@@ -103,3 +106,116 @@ func TestDecodeEncodeAndDecodeCounterSample(t *testing.T) {
 		t.Errorf("expected\n%#v, got\n%#v", expectedGenericInterfaceCounters, genericInterfaceCounters)
 	}
 }
+
+func TestEncodeDecodeVirtualMachineCounterSample(t *testing.T) {
+	sample := &CounterSample{
+		SequenceNum:      7,
+		SourceIdType:     3,
+		SourceIdIndexVal: 100001,
+		Records: []records.Record{
+			records.HostParent{ContainerType: 2, ContainerIndex: 1},
+			records.VirtCPUCounter{State: records.VirtDomainStateRunning, CPUTime: 123456, NrVirtCPU: 2},
+			records.VirtMemoryCounter{Memory: 2 << 30, MaxMemory: 4 << 30},
+			records.VirtDiskIOCounter{Capacity: 40 << 30, Allocation: 10 << 30, Available: 30 << 30, RdReq: 10, RdBytes: 40960, WrReq: 5, WrBytes: 20480},
+			records.VirtNetIOCounter{RxBytes: 1 << 33, RxPackets: 1000, TxBytes: 1 << 32, TxPackets: 900, TxDrop: 1},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+
+	err := sample.encode(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// We need to skip the first 8 bytes. That's the header.
+	var skip [8]byte
+	buf.Read(skip[:])
+
+	decodedSample, err := decodeCounterSample(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, ok := decodedSample.(*CounterSample)
+	if !ok {
+		t.Fatalf("expected a CounterSample, got %T", decodedSample)
+	}
+
+	if !reflect.DeepEqual(sample.Records, decoded.Records) {
+		t.Errorf("expected\n%+#v\n, got\n%+#v", sample.Records, decoded.Records)
+	}
+
+	if !decoded.IsVirtual() {
+		t.Error("expected a sample with a host_parent record to be virtual")
+	}
+
+	if parent, _ := decoded.HostParent(); parent.ContainerIndex != 1 {
+		t.Errorf("expected parent container index 1, got %d", parent.ContainerIndex)
+	}
+
+	physical := &CounterSample{Records: []records.Record{records.VirtNodeCounter{MHz: 2400, CPUs: 8}}}
+	if physical.IsVirtual() {
+		t.Error("expected a sample without a host_parent record to be physical")
+	}
+}
+
+func TestEncodeDecodeOpenVSwitchCounterSample(t *testing.T) {
+	sample := &CounterSample{
+		SequenceNum:      12,
+		SourceIdType:     0,
+		SourceIdIndexVal: 3,
+		Records: []records.Record{
+			GenericInterfaceCounters{Index: 3, Type: 6, Speed: 10000000000, Direction: 1, Status: 3, InOctets: 123456, OutOctets: 654321},
+			records.OFPortCounter{DatapathID: 0x0000525400a1b2c3, PortNo: 2},
+			records.PortNameCounter{NameLen: 5, Name: []byte("vnet0")},
+			records.OVSDPStatsCounter{Hits: 9000, Misses: 120, Lost: 1, MaskHits: 15000, Flows: 42, Masks: 6},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+
+	err := sample.encode(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// We need to skip the first 8 bytes. That's the header.
+	var skip [8]byte
+	buf.Read(skip[:])
+
+	decodedSample, err := decodeCounterSample(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, ok := decodedSample.(*CounterSample)
+	if !ok {
+		t.Fatalf("expected a CounterSample, got %T", decodedSample)
+	}
+
+	if !reflect.DeepEqual(sample.Records, decoded.Records) {
+		t.Errorf("expected\n%+#v\n, got\n%+#v", sample.Records, decoded.Records)
+	}
+}
+
+func TestDecodeCounterRecordsSkipsInvalidRecords(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	// app_operations whose application name length exceeds the record length
+	binary.Write(buf, binary.BigEndian, []uint32{records.TypeAppOperationsCounterRecord, 8, 0xfffffff0, 0})
+
+	rec := records.AppWorkersCounter{WorkersActive: 4, WorkersIdle: 12, WorkersMax: 16}
+	if err := rec.Encode(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	recs, err := decodeCounterRecords(bytes.NewReader(buf.Bytes()), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(recs, []records.Record{rec}) {
+		t.Errorf("expected\n%+#v\n, got\n%+#v", []records.Record{rec}, recs)
+	}
+}
@@ -112,8 +112,30 @@ func TestDecodeHostCounters(t *testing.T) {
 		t.Fatalf("expected a CounterSample, got %T", dgram.Samples[0])
 	}
 
-	if len(sample.Records) != 4 {
-		t.Fatalf("expected 4 records, got %d", len(sample.Records))
+	if len(sample.Records) != 6 {
+		t.Fatalf("expected 6 records, got %d", len(sample.Records))
+	}
+
+	descr, ok := sample.Records[5].(records.HostDescriptionCounter)
+	if !ok {
+		t.Fatalf("expected a HostDescriptionCounter, got %T", sample.Records[5])
+	}
+
+	if string(descr.Hostname) != "fractal" || string(descr.OSRelease) != "3.13.0-29-generic" {
+		t.Errorf("unexpected hostname %q or os release %q", descr.Hostname, descr.OSRelease)
+	}
+
+	if descr.MachineType != records.MachineTypeX86_64 || descr.OSName != records.OSNameLinux {
+		t.Errorf("expected x86_64 and linux, got %s and %s", descr.MachineType, descr.OSName)
+	}
+
+	adapters, ok := sample.Records[0].(records.HostAdapters)
+	if !ok {
+		t.Fatalf("expected HostAdapters, got %T", sample.Records[0])
+	}
+
+	if len(adapters.Adapters) != 2 || adapters.Adapters[0].IfIndex != 2 {
+		t.Errorf("unexpected adapters %+v", adapters.Adapters)
 	}
 
 	// TODO: check values
@@ -0,0 +1,102 @@
+package sflow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/yseto/sflow/records"
+	"io"
+)
+
+// DiscardedPacketSample is a dropped packet notification sample.
+type DiscardedPacketSample struct {
+	SequenceNum      uint32
+	SourceIdType     uint32
+	SourceIdIndexVal uint32
+	Drops            uint32
+	Input            uint32
+	Output           uint32
+	Reason           DropReason
+	numRecords       uint32
+	Records          []records.Record
+}
+
+func (s DiscardedPacketSample) String() string {
+	type X DiscardedPacketSample
+	x := X(s)
+	return fmt.Sprintf("DiscardedPacketSample: %+v", x)
+}
+
+// SampleType returns the type of sFlow sample.
+func (s *DiscardedPacketSample) SampleType() int {
+	return TypeDiscardedPacketSample
+}
+
+func (s *DiscardedPacketSample) GetRecords() []records.Record {
+	return s.Records
+}
+
+func decodeDiscardedPacketSample(r io.ReadSeeker) (Sample, error) {
+	s := &DiscardedPacketSample{}
+
+	var err error
+
+	fields := []interface{}{
+		&s.SequenceNum,
+		&s.SourceIdType,
+		&s.SourceIdIndexVal,
+		&s.Drops,
+		&s.Input,
+		&s.Output,
+		&s.Reason,
+		&s.numRecords,
+	}
+
+	for _, field := range fields {
+		err = binary.Read(r, binary.BigEndian, field)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.Records, err = decodeFlowRecords(r, s.numRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *DiscardedPacketSample) encode(w io.Writer) error {
+	// We first need to encode the records.
+	buf, err := encodeRecords(s.Records)
+	if err != nil {
+		return err
+	}
+
+	// Fields
+	encodedSampleSize := uint32(4 + 4 + 4 + 4 + 4 + 4 + 4 + 4)
+
+	// Encoded records
+	encodedSampleSize += uint32(buf.Len())
+
+	fields := []uint32{
+		uint32(s.SampleType()),
+		encodedSampleSize,
+		s.SequenceNum,
+		s.SourceIdType,
+		s.SourceIdIndexVal,
+		s.Drops,
+		s.Input,
+		s.Output,
+		uint32(s.Reason),
+		uint32(len(s.Records)),
+	}
+
+	err = binary.Write(w, binary.BigEndian, fields)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, buf)
+	return err
+}
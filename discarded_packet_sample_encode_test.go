@@ -0,0 +1,70 @@
+package sflow
+
+import (
+	"bytes"
+	"github.com/yseto/sflow/records"
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeDecodeDiscardedPacketSample(t *testing.T) {
+	sample := &DiscardedPacketSample{
+		SequenceNum:      3,
+		SourceIdType:     0,
+		SourceIdIndexVal: 2,
+		Drops:            0,
+		Input:            2,
+		Output:           0,
+		Reason:           DropReasonNoBufferSpace,
+		Records: []records.Record{
+			records.ExtendedEgressQueueFlow{
+				Queue: 5,
+			},
+			records.ExtendedFunctionFlow{
+				SymbolLen: 17,
+				Symbol:    []byte("tcp_v4_do_rcv+0x1"),
+			},
+			records.ExtendedLinuxDropReasonFlow{
+				ReasonLen: 14,
+				Reason:    []byte("NETFILTER_DROP"),
+			},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	enc := NewEncoder(net.ParseIP("192.0.2.1"), 0, 1)
+
+	err := enc.Encode(buf, []Sample{sample})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewDecoder(bytes.NewReader(buf.Bytes()))
+
+	dgram, err := d.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dgram.Samples) != 1 {
+		t.Fatalf("expected 1 sample, got %d", len(dgram.Samples))
+	}
+
+	decoded, ok := dgram.Samples[0].(*DiscardedPacketSample)
+	if !ok {
+		t.Fatalf("expected a DiscardedPacketSample, got %T", dgram.Samples[0])
+	}
+
+	if decoded.Reason != DropReasonNoBufferSpace {
+		t.Errorf("expected Reason to be %s, got %s", DropReasonNoBufferSpace, decoded.Reason)
+	}
+
+	if decoded.Input != sample.Input {
+		t.Errorf("expected Input to be %d, got %d", sample.Input, decoded.Input)
+	}
+
+	if !reflect.DeepEqual(sample.Records, decoded.Records) {
+		t.Errorf("expected\n%+#v\n, got\n%+#v", sample.Records, decoded.Records)
+	}
+}
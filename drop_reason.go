@@ -0,0 +1,155 @@
+package sflow
+
+import "fmt"
+
+// DropReason is the reason code of a discarded packet sample,
+// as defined in the sFlow Dropped Packet Notification Structures.
+type DropReason uint32
+
+// Drop reasons mirroring the ICMP destination unreachable codes
+const (
+	DropReasonNetUnreachable          DropReason = 0
+	DropReasonHostUnreachable         DropReason = 1
+	DropReasonProtocolUnreachable     DropReason = 2
+	DropReasonPortUnreachable         DropReason = 3
+	DropReasonFragNeeded              DropReason = 4
+	DropReasonSrcRouteFailed          DropReason = 5
+	DropReasonDstNetUnknown           DropReason = 6
+	DropReasonDstHostUnknown          DropReason = 7
+	DropReasonSrcHostIsolated         DropReason = 8
+	DropReasonDstNetProhibited        DropReason = 9
+	DropReasonDstHostProhibited       DropReason = 10
+	DropReasonDstNetTOSUnreachable    DropReason = 11
+	DropReasonDstHostTOSUnreachable   DropReason = 12
+	DropReasonCommAdminProhibited     DropReason = 13
+	DropReasonHostPrecedenceViolation DropReason = 14
+	DropReasonPrecedenceCutoff        DropReason = 15
+)
+
+// Drop reasons reported by switch ASICs and the Linux kernel
+const (
+	DropReasonUnknown                      DropReason = 256
+	DropReasonTTLExceeded                  DropReason = 257
+	DropReasonACL                          DropReason = 258
+	DropReasonNoBufferSpace                DropReason = 259
+	DropReasonRED                          DropReason = 260
+	DropReasonTrafficShaping               DropReason = 261
+	DropReasonPktTooBig                    DropReason = 262
+	DropReasonSrcMACIsMulticast            DropReason = 263
+	DropReasonVLANTagMismatch              DropReason = 264
+	DropReasonIngressVLANFilter            DropReason = 265
+	DropReasonIngressSpanningTreeFilter    DropReason = 266
+	DropReasonPortListIsEmpty              DropReason = 267
+	DropReasonPortLoopbackFilter           DropReason = 268
+	DropReasonBlackholeRoute               DropReason = 269
+	DropReasonNonIP                        DropReason = 270
+	DropReasonUCDIPOverMCDMAC              DropReason = 271
+	DropReasonDIPIsLoopbackAddress         DropReason = 272
+	DropReasonSIPIsMC                      DropReason = 273
+	DropReasonSIPIsLoopbackAddress         DropReason = 274
+	DropReasonIPHeaderCorrupted            DropReason = 275
+	DropReasonIPv4SIPIsLimitedBC           DropReason = 276
+	DropReasonIPv6MCDIPReservedScope       DropReason = 277
+	DropReasonIPv6MCDIPInterfaceLocalScope DropReason = 278
+	DropReasonUnresolvedNeigh              DropReason = 279
+	DropReasonMCReversePathForwarding      DropReason = 280
+	DropReasonNonRoutablePacket            DropReason = 281
+	DropReasonDecapError                   DropReason = 282
+	DropReasonOverlaySMACIsMC              DropReason = 283
+	DropReasonUnknownL2                    DropReason = 284
+	DropReasonUnknownL3                    DropReason = 285
+	DropReasonUnknownL3Exception           DropReason = 286
+	DropReasonUnknownBuffer                DropReason = 287
+	DropReasonUnknownTunnel                DropReason = 288
+	DropReasonUnknownL4                    DropReason = 289
+	DropReasonSIPIsUnspecified             DropReason = 290
+	DropReasonMLAGPortIsolation            DropReason = 291
+	DropReasonBlackholeARPNeigh            DropReason = 292
+	DropReasonSrcMACIsDMAC                 DropReason = 293
+	DropReasonDMACIsReserved               DropReason = 294
+	DropReasonSIPIsClassE                  DropReason = 295
+	DropReasonMCDMACMismatch               DropReason = 296
+	DropReasonSIPIsDIP                     DropReason = 297
+	DropReasonDIPIsLocalNetwork            DropReason = 298
+	DropReasonDIPIsLinkLocal               DropReason = 299
+	DropReasonOverlaySMACIsDMAC            DropReason = 300
+	DropReasonEgressVLANFilter             DropReason = 301
+	DropReasonUCReversePathForwarding      DropReason = 302
+	DropReasonSplitHorizon                 DropReason = 303
+)
+
+var dropReasonNames = map[DropReason]string{
+	DropReasonNetUnreachable:               "net_unreachable",
+	DropReasonHostUnreachable:              "host_unreachable",
+	DropReasonProtocolUnreachable:          "protocol_unreachable",
+	DropReasonPortUnreachable:              "port_unreachable",
+	DropReasonFragNeeded:                   "frag_needed",
+	DropReasonSrcRouteFailed:               "src_route_failed",
+	DropReasonDstNetUnknown:                "dst_net_unknown",
+	DropReasonDstHostUnknown:               "dst_host_unknown",
+	DropReasonSrcHostIsolated:              "src_host_isolated",
+	DropReasonDstNetProhibited:             "dst_net_prohibited",
+	DropReasonDstHostProhibited:            "dst_host_prohibited",
+	DropReasonDstNetTOSUnreachable:         "dst_net_tos_unreachable",
+	DropReasonDstHostTOSUnreachable:        "dst_host_tos_unreachable",
+	DropReasonCommAdminProhibited:          "comm_admin_prohibited",
+	DropReasonHostPrecedenceViolation:      "host_precedence_violation",
+	DropReasonPrecedenceCutoff:             "precedence_cutoff",
+	DropReasonUnknown:                      "unknown",
+	DropReasonTTLExceeded:                  "ttl_exceeded",
+	DropReasonACL:                          "acl",
+	DropReasonNoBufferSpace:                "no_buffer_space",
+	DropReasonRED:                          "red",
+	DropReasonTrafficShaping:               "traffic_shaping",
+	DropReasonPktTooBig:                    "pkt_too_big",
+	DropReasonSrcMACIsMulticast:            "src_mac_is_multicast",
+	DropReasonVLANTagMismatch:              "vlan_tag_mismatch",
+	DropReasonIngressVLANFilter:            "ingress_vlan_filter",
+	DropReasonIngressSpanningTreeFilter:    "ingress_spanning_tree_filter",
+	DropReasonPortListIsEmpty:              "port_list_is_empty",
+	DropReasonPortLoopbackFilter:           "port_loopback_filter",
+	DropReasonBlackholeRoute:               "blackhole_route",
+	DropReasonNonIP:                        "non_ip",
+	DropReasonUCDIPOverMCDMAC:              "uc_dip_over_mc_dmac",
+	DropReasonDIPIsLoopbackAddress:         "dip_is_loopback_address",
+	DropReasonSIPIsMC:                      "sip_is_mc",
+	DropReasonSIPIsLoopbackAddress:         "sip_is_loopback_address",
+	DropReasonIPHeaderCorrupted:            "ip_header_corrupted",
+	DropReasonIPv4SIPIsLimitedBC:           "ipv4_sip_is_limited_bc",
+	DropReasonIPv6MCDIPReservedScope:       "ipv6_mc_dip_reserved_scope",
+	DropReasonIPv6MCDIPInterfaceLocalScope: "ipv6_mc_dip_interface_local_scope",
+	DropReasonUnresolvedNeigh:              "unresolved_neigh",
+	DropReasonMCReversePathForwarding:      "mc_reverse_path_forwarding",
+	DropReasonNonRoutablePacket:            "non_routable_packet",
+	DropReasonDecapError:                   "decap_error",
+	DropReasonOverlaySMACIsMC:              "overlay_smac_is_mc",
+	DropReasonUnknownL2:                    "unknown_l2",
+	DropReasonUnknownL3:                    "unknown_l3",
+	DropReasonUnknownL3Exception:           "unknown_l3_exception",
+	DropReasonUnknownBuffer:                "unknown_buffer",
+	DropReasonUnknownTunnel:                "unknown_tunnel",
+	DropReasonUnknownL4:                    "unknown_l4",
+	DropReasonSIPIsUnspecified:             "sip_is_unspecified",
+	DropReasonMLAGPortIsolation:            "mlag_port_isolation",
+	DropReasonBlackholeARPNeigh:            "blackhole_arp_neigh",
+	DropReasonSrcMACIsDMAC:                 "src_mac_is_dmac",
+	DropReasonDMACIsReserved:               "dmac_is_reserved",
+	DropReasonSIPIsClassE:                  "sip_is_class_e",
+	DropReasonMCDMACMismatch:               "mc_dmac_mismatch",
+	DropReasonSIPIsDIP:                     "sip_is_dip",
+	DropReasonDIPIsLocalNetwork:            "dip_is_local_network",
+	DropReasonDIPIsLinkLocal:               "dip_is_link_local",
+	DropReasonOverlaySMACIsDMAC:            "overlay_smac_is_dmac",
+	DropReasonEgressVLANFilter:             "egress_vlan_filter",
+	DropReasonUCReversePathForwarding:      "uc_reverse_path_forwarding",
+	DropReasonSplitHorizon:                 "split_horizon",
+}
+
+// String returns the name of the drop reason as used in the sFlow specification.
+func (r DropReason) String() string {
+	if name, found := dropReasonNames[r]; found {
+		return name
+	}
+
+	return fmt.Sprintf("DropReason(%d)", uint32(r))
+}
@@ -0,0 +1,104 @@
+package sflow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/yseto/sflow/records"
+	"io"
+)
+
+// ExpandedCounterSample is a counter sample with an expanded source_id,
+// used by agents with ifIndex values >= 2^24.
+type ExpandedCounterSample struct {
+	SequenceNum      uint32
+	SourceIdType     uint32
+	SourceIdIndexVal uint32
+	numRecords       uint32
+	Records          []records.Record
+}
+
+func (s ExpandedCounterSample) String() string {
+	type X ExpandedCounterSample
+	x := X(s)
+	return fmt.Sprintf("ExpandedCounterSample: %+v", x)
+}
+
+// SampleType returns the type of sFlow sample.
+func (s *ExpandedCounterSample) SampleType() int {
+	return TypeExpandedCounterSample
+}
+
+func (s *ExpandedCounterSample) GetRecords() []records.Record {
+	return s.Records
+}
+
+// HostParent returns the host_parent record of the sample, if present.
+// Physical hosts do not report a parent.
+func (s *ExpandedCounterSample) HostParent() (records.HostParent, bool) {
+	return hostParent(s.Records)
+}
+
+// IsVirtual reports whether the source ID of the sample identifies a virtual
+// machine, i.e. the sample carries a host_parent record pointing to its hypervisor.
+func (s *ExpandedCounterSample) IsVirtual() bool {
+	_, ok := hostParent(s.Records)
+	return ok
+}
+
+func decodeExpandedCounterSample(r io.ReadSeeker) (Sample, error) {
+	s := &ExpandedCounterSample{}
+
+	var err error
+
+	fields := []interface{}{
+		&s.SequenceNum,
+		&s.SourceIdType,
+		&s.SourceIdIndexVal,
+		&s.numRecords,
+	}
+
+	for _, field := range fields {
+		err = binary.Read(r, binary.BigEndian, field)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.Records, err = decodeCounterRecords(r, s.numRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *ExpandedCounterSample) encode(w io.Writer) error {
+	// We first need to encode the records.
+	buf, err := encodeRecords(s.Records)
+	if err != nil {
+		return err
+	}
+
+	// Fields
+	encodedSampleSize := uint32(4 + 4 + 4 + 4)
+
+	// Encoded records
+	encodedSampleSize += uint32(buf.Len())
+
+	fields := []uint32{
+		uint32(s.SampleType()),
+		encodedSampleSize,
+		s.SequenceNum,
+		s.SourceIdType,
+		s.SourceIdIndexVal,
+		uint32(len(s.Records)),
+	}
+
+	err = binary.Write(w, binary.BigEndian, fields)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, buf)
+	return err
+}
@@ -0,0 +1,96 @@
+package sflow
+
+import (
+	"bytes"
+	"github.com/yseto/sflow/records"
+	"net"
+	"testing"
+)
+
+func TestEncodeDecodeExpandedCounterSample(t *testing.T) {
+	sample := &ExpandedCounterSample{
+		SequenceNum:      7,
+		SourceIdType:     0,
+		SourceIdIndexVal: 1<<24 + 9,
+		Records: []records.Record{
+			GenericInterfaceCounters{
+				Index:     1<<24 + 9,
+				Type:      6,
+				Speed:     100000000000,
+				Direction: 1,
+				Status:    3,
+				InOctets:  79282473,
+				OutOctets: 764247430,
+			},
+			EthernetCounters{
+				FCSErrors: 3,
+			},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	enc := NewEncoder(net.ParseIP("2001:db8::1"), 0, 1)
+
+	err := enc.Encode(buf, []Sample{sample})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewDecoder(bytes.NewReader(buf.Bytes()))
+
+	dgram, err := d.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dgram.Samples) != 1 {
+		t.Fatalf("expected 1 sample, got %d", len(dgram.Samples))
+	}
+
+	decoded, ok := dgram.Samples[0].(*ExpandedCounterSample)
+	if !ok {
+		t.Fatalf("expected an ExpandedCounterSample, got %T", dgram.Samples[0])
+	}
+
+	if decoded.SequenceNum != sample.SequenceNum {
+		t.Errorf("expected SequenceNum to be %d, got %d", sample.SequenceNum, decoded.SequenceNum)
+	}
+
+	if decoded.SourceIdIndexVal != sample.SourceIdIndexVal {
+		t.Errorf("expected SourceIdIndexVal to be %d, got %d", sample.SourceIdIndexVal, decoded.SourceIdIndexVal)
+	}
+
+	if len(decoded.Records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(decoded.Records))
+	}
+
+	for i := range sample.Records {
+		if decoded.Records[i] != sample.Records[i] {
+			t.Errorf("expected\n%+#v\n, got\n%+#v", sample.Records[i], decoded.Records[i])
+		}
+	}
+}
+
+func TestExpandedCounterSampleIsVirtual(t *testing.T) {
+	sample := &ExpandedCounterSample{
+		SourceIdType:     3,
+		SourceIdIndexVal: 1<<24 + 100001,
+		Records: []records.Record{
+			records.HostParent{ContainerType: 2, ContainerIndex: 1},
+			records.VirtCPUCounter{State: records.VirtDomainStateRunning, NrVirtCPU: 2},
+		},
+	}
+
+	if !sample.IsVirtual() {
+		t.Error("expected a sample with a host_parent record to be virtual")
+	}
+
+	if parent, _ := sample.HostParent(); parent.ContainerIndex != 1 {
+		t.Errorf("expected parent container index 1, got %d", parent.ContainerIndex)
+	}
+
+	physical := &ExpandedCounterSample{Records: []records.Record{records.VirtNodeCounter{MHz: 2400, CPUs: 8}}}
+	if physical.IsVirtual() {
+		t.Error("expected a sample without a host_parent record to be physical")
+	}
+}
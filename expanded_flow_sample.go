@@ -0,0 +1,112 @@
+package sflow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/yseto/sflow/records"
+	"io"
+)
+
+// ExpandedFlowSample is a flow sample with expanded source_id and
+// interface fields, used by agents with ifIndex values >= 2^24.
+type ExpandedFlowSample struct {
+	SequenceNum      uint32
+	SourceIdType     uint32
+	SourceIdIndexVal uint32
+	SamplingRate     uint32
+	SamplePool       uint32
+	Drops            uint32
+	InputFormat      uint32
+	InputValue       uint32
+	OutputFormat     uint32
+	OutputValue      uint32
+	numRecords       uint32
+	Records          []records.Record
+}
+
+func (s ExpandedFlowSample) String() string {
+	type X ExpandedFlowSample
+	x := X(s)
+	return fmt.Sprintf("ExpandedFlowSample: %+v", x)
+}
+
+// SampleType returns the type of sFlow sample.
+func (s *ExpandedFlowSample) SampleType() int {
+	return TypeExpandedFlowSample
+}
+
+func (s *ExpandedFlowSample) GetRecords() []records.Record {
+	return s.Records
+}
+
+func decodeExpandedFlowSample(r io.ReadSeeker) (Sample, error) {
+	s := &ExpandedFlowSample{}
+
+	var err error
+
+	fields := []interface{}{
+		&s.SequenceNum,
+		&s.SourceIdType,
+		&s.SourceIdIndexVal,
+		&s.SamplingRate,
+		&s.SamplePool,
+		&s.Drops,
+		&s.InputFormat,
+		&s.InputValue,
+		&s.OutputFormat,
+		&s.OutputValue,
+		&s.numRecords,
+	}
+
+	for _, field := range fields {
+		err = binary.Read(r, binary.BigEndian, field)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.Records, err = decodeFlowRecords(r, s.numRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *ExpandedFlowSample) encode(w io.Writer) error {
+	// We first need to encode the records.
+	buf, err := encodeRecords(s.Records)
+	if err != nil {
+		return err
+	}
+
+	// Fields
+	encodedSampleSize := uint32(4 + 4 + 4 + 4 + 4 + 4 + 4 + 4 + 4 + 4 + 4)
+
+	// Encoded records
+	encodedSampleSize += uint32(buf.Len())
+
+	fields := []uint32{
+		uint32(s.SampleType()),
+		encodedSampleSize,
+		s.SequenceNum,
+		s.SourceIdType,
+		s.SourceIdIndexVal,
+		s.SamplingRate,
+		s.SamplePool,
+		s.Drops,
+		s.InputFormat,
+		s.InputValue,
+		s.OutputFormat,
+		s.OutputValue,
+		uint32(len(s.Records)),
+	}
+
+	err = binary.Write(w, binary.BigEndian, fields)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, buf)
+	return err
+}
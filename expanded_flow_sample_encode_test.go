@@ -0,0 +1,75 @@
+package sflow
+
+import (
+	"bytes"
+	"github.com/yseto/sflow/records"
+	"net"
+	"testing"
+)
+
+func TestEncodeDecodeExpandedFlowSample(t *testing.T) {
+	sample := &ExpandedFlowSample{
+		SequenceNum:      42,
+		SourceIdType:     0,
+		SourceIdIndexVal: 1 << 25,
+		SamplingRate:     1024,
+		SamplePool:       4096,
+		Drops:            1,
+		InputFormat:      0,
+		InputValue:       1<<24 + 1,
+		OutputFormat:     0,
+		OutputValue:      1<<24 + 2,
+		Records: []records.Record{
+			records.ExtendedSwitchFlow{
+				SourceVlan:          100,
+				SourcePriority:      1,
+				DestinationVlan:     200,
+				DestinationPriority: 2,
+			},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	enc := NewEncoder(net.ParseIP("192.0.2.1"), 0, 1)
+
+	err := enc.Encode(buf, []Sample{sample})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewDecoder(bytes.NewReader(buf.Bytes()))
+
+	dgram, err := d.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dgram.Samples) != 1 {
+		t.Fatalf("expected 1 sample, got %d", len(dgram.Samples))
+	}
+
+	decoded, ok := dgram.Samples[0].(*ExpandedFlowSample)
+	if !ok {
+		t.Fatalf("expected an ExpandedFlowSample, got %T", dgram.Samples[0])
+	}
+
+	if decoded.SourceIdIndexVal != sample.SourceIdIndexVal {
+		t.Errorf("expected SourceIdIndexVal to be %d, got %d", sample.SourceIdIndexVal, decoded.SourceIdIndexVal)
+	}
+
+	if decoded.InputValue != sample.InputValue {
+		t.Errorf("expected InputValue to be %d, got %d", sample.InputValue, decoded.InputValue)
+	}
+
+	if decoded.OutputValue != sample.OutputValue {
+		t.Errorf("expected OutputValue to be %d, got %d", sample.OutputValue, decoded.OutputValue)
+	}
+
+	if len(decoded.Records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(decoded.Records))
+	}
+
+	if decoded.Records[0] != sample.Records[0] {
+		t.Errorf("expected\n%+#v\n, got\n%+#v", sample.Records[0], decoded.Records[0])
+	}
+}
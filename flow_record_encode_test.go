@@ -51,7 +51,7 @@ func TestEncodeDecodeRawPacketFlowRecord(t *testing.T) {
 	}
 
 	decoded, err := records.DecodeRawPacketFlow(b)
-	decoded.DecodedHeader = nil // We don't care if we decoded the header here
+	decoded.Packet = nil // We don't care if we decoded the header here
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -63,8 +63,8 @@ func decodeFlowSample(r io.ReadSeeker) (Sample, error) {
 	}
 
 	s.SourceIdIndexVal = uint32(srcIdIndexVal[2]) |
-		uint32(srcIdIndexVal[1]<<8) |
-		uint32(srcIdIndexVal[0]<<16)
+		uint32(srcIdIndexVal[1])<<8 |
+		uint32(srcIdIndexVal[0])<<16
 
 	err = binary.Read(r, binary.BigEndian, &s.SamplingRate)
 	if err != nil {
@@ -96,48 +96,19 @@ func decodeFlowSample(r io.ReadSeeker) (Sample, error) {
 		return nil, err
 	}
 
-	for i := uint32(0); i < s.numRecords; i++ {
-		format, length := uint32(0), uint32(0)
-
-		err = binary.Read(r, binary.BigEndian, &format)
-		if err != nil {
-			return nil, err
-		}
-
-		err = binary.Read(r, binary.BigEndian, &length)
-		if err != nil {
-			return nil, err
-		}
-
-		var rec records.Record
-		//fmt.Printf("sflow: Decoding record type %d with length %d\n", format, length)
-
-		if rec, err = records.DecodeFlow(r, format); err != nil {
-			//return nil, err
-			_, err := r.Seek(int64(length), 1)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		}
-
-		s.Records = append(s.Records, rec)
+	s.Records, err = decodeFlowRecords(r, s.numRecords)
+	if err != nil {
+		return nil, err
 	}
 
 	return s, nil
 }
 
 func (s *FlowSample) encode(w io.Writer) error {
-	var err error
-
 	// We first need to encode the records.
-	buf := &bytes.Buffer{}
-
-	for _, rec := range s.Records {
-		err = rec.Encode(buf)
-		if err != nil {
-			return records.ErrEncodingRecord
-		}
+	buf, err := encodeRecords(s.Records)
+	if err != nil {
+		return err
 	}
 
 	// Fields
@@ -159,7 +130,7 @@ func (s *FlowSample) encode(w io.Writer) error {
 		return err
 	}
 	err = binary.Write(w, binary.BigEndian,
-		uint32(s.SourceIdType)|s.SourceIdIndexVal<<24)
+		uint32(s.SourceIdType)<<24|s.SourceIdIndexVal&0xffffff)
 	if err != nil {
 		return err
 	}
@@ -191,3 +162,59 @@ func (s *FlowSample) encode(w io.Writer) error {
 	_, err = io.Copy(w, buf)
 	return err
 }
+
+// decodeFlowRecords decodes numRecords flow records from r.
+// Records of unknown types are skipped.
+func decodeFlowRecords(r io.ReadSeeker, numRecords uint32) ([]records.Record, error) {
+	var recs []records.Record
+
+	for i := uint32(0); i < numRecords; i++ {
+		format, length := uint32(0), uint32(0)
+
+		err := binary.Read(r, binary.BigEndian, &format)
+		if err != nil {
+			return nil, err
+		}
+
+		err = binary.Read(r, binary.BigEndian, &length)
+		if err != nil {
+			return nil, err
+		}
+
+		//fmt.Printf("sflow: Decoding record type %d with length %d\n", format, length)
+
+		// The record must not read past its own length
+		lr := &io.LimitedReader{R: r, N: int64(length)}
+
+		rec, err := records.DecodeFlow(lr, format)
+
+		// Skip unknown records and anything the decoder did not consume
+		if _, err := io.Copy(io.Discard, lr); err != nil {
+			return nil, err
+		}
+
+		if err != nil {
+			//return nil, err
+			continue
+		}
+
+		recs = append(recs, rec)
+	}
+
+	return recs, nil
+}
+
+// encodeRecords encodes recs into a buffer so that
+// the encoded size is known before the sample header is written.
+func encodeRecords(recs []records.Record) (*bytes.Buffer, error) {
+	buf := &bytes.Buffer{}
+
+	for _, rec := range recs {
+		err := rec.Encode(buf)
+		if err != nil {
+			return nil, records.ErrEncodingRecord
+		}
+	}
+
+	return buf, nil
+}
@@ -2,8 +2,10 @@ package sflow
 
 import (
 	"bytes"
+	"encoding/binary"
 	"github.com/yseto/sflow/records"
 	"os"
+	"reflect"
 	"testing"
 )
 
@@ -84,3 +86,24 @@ func TestDecodeEncodeAndDecodeFlowSample(t *testing.T) {
 		t.Errorf("expected FrameLength to be 128, got %d", rec.HeaderSize)
 	}
 }
+
+func TestDecodeFlowRecordsSkipsOversizedLength(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	// extended_url whose URL length exceeds the record length
+	binary.Write(buf, binary.BigEndian, []uint32{records.TypeExtendedURLFlowRecord, 8, 1, 0xfffffff0})
+
+	rec := records.ExtendedSwitchFlow{SourceVlan: 100, DestinationVlan: 200}
+	if err := rec.Encode(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	recs, err := decodeFlowRecords(bytes.NewReader(buf.Bytes()), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(recs, []records.Record{rec}) {
+		t.Errorf("expected\n%+#v\n, got\n%+#v", []records.Record{rec}, recs)
+	}
+}
@@ -0,0 +1,443 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// AppOperationsCounter - TypeAppOperationsCounterRecord
+type AppOperationsCounter struct {
+	ApplicationLen uint32
+	Application    XDRString `lengthLookUp:"ApplicationLen" maxLength:"32"` /* application name */
+	Success        uint32
+	Other          uint32
+	Timeout        uint32
+	InternalError  uint32
+	BadRequest     uint32
+	Forbidden      uint32
+	TooLarge       uint32
+	NotImplemented uint32
+	NotFound       uint32
+	Unavailable    uint32
+	Unauthorized   uint32
+}
+
+func (f AppOperationsCounter) String() string {
+	type X AppOperationsCounter
+	x := X(f)
+	return fmt.Sprintf("AppOperationsCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f AppOperationsCounter) RecordName() string {
+	return "AppOperationsCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f AppOperationsCounter) RecordType() int {
+	return TypeAppOperationsCounterRecord
+}
+
+func (f AppOperationsCounter) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.ApplicationLen)
+	size += len(f.Application) + xdrPadding(len(f.Application))
+	size += binary.Size(f.Success)
+	size += binary.Size(f.Other)
+	size += binary.Size(f.Timeout)
+	size += binary.Size(f.InternalError)
+	size += binary.Size(f.BadRequest)
+	size += binary.Size(f.Forbidden)
+	size += binary.Size(f.TooLarge)
+	size += binary.Size(f.NotImplemented)
+	size += binary.Size(f.NotFound)
+	size += binary.Size(f.Unavailable)
+	size += binary.Size(f.Unauthorized)
+
+	return size
+}
+
+func (f AppOperationsCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// AppResourcesCounter - TypeAppResourcesCounterRecord
+type AppResourcesCounter struct {
+	UserTime   uint32 /* in milliseconds */
+	SystemTime uint32 /* in milliseconds */
+	MemUsed    uint64 /* memory used in bytes */
+	MemMax     uint64 /* max memory in bytes */
+	FDOpen     uint32 /* number of open file descriptors */
+	FDMax      uint32 /* max number of file descriptors */
+	ConnOpen   uint32 /* number of open network connections */
+	ConnMax    uint32 /* max number of network connections */
+}
+
+func (f AppResourcesCounter) String() string {
+	type X AppResourcesCounter
+	x := X(f)
+	return fmt.Sprintf("AppResourcesCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f AppResourcesCounter) RecordName() string {
+	return "AppResourcesCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f AppResourcesCounter) RecordType() int {
+	return TypeAppResourcesCounterRecord
+}
+
+func (f AppResourcesCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f AppResourcesCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// AppWorkersCounter - TypeAppWorkersCounterRecord
+type AppWorkersCounter struct {
+	WorkersActive uint32 /* number of active workers */
+	WorkersIdle   uint32 /* number of idle workers */
+	WorkersMax    uint32 /* max number of workers */
+	ReqDelayed    uint32 /* number of requests delayed */
+	ReqDropped    uint32 /* number of requests dropped */
+}
+
+func (f AppWorkersCounter) String() string {
+	type X AppWorkersCounter
+	x := X(f)
+	return fmt.Sprintf("AppWorkersCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f AppWorkersCounter) RecordName() string {
+	return "AppWorkersCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f AppWorkersCounter) RecordType() int {
+	return TypeAppWorkersCounterRecord
+}
+
+func (f AppWorkersCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f AppWorkersCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// AppStatus is the result of an application operation, see AppOperationFlow.
+type AppStatus uint32
+
+// Application operation status values as defined in the sFlow Application Structures
+const (
+	AppStatusSuccess        AppStatus = 0
+	AppStatusOther          AppStatus = 1
+	AppStatusTimeout        AppStatus = 2
+	AppStatusInternalError  AppStatus = 3
+	AppStatusBadRequest     AppStatus = 4
+	AppStatusForbidden      AppStatus = 5
+	AppStatusTooLarge       AppStatus = 6
+	AppStatusNotImplemented AppStatus = 7
+	AppStatusNotFound       AppStatus = 8
+	AppStatusUnavailable    AppStatus = 9
+	AppStatusUnauthorized   AppStatus = 10
+)
+
+var appStatusNames = map[AppStatus]string{
+	AppStatusSuccess:        "SUCCESS",
+	AppStatusOther:          "OTHER",
+	AppStatusTimeout:        "TIMEOUT",
+	AppStatusInternalError:  "INTERNAL_ERROR",
+	AppStatusBadRequest:     "BAD_REQUEST",
+	AppStatusForbidden:      "FORBIDDEN",
+	AppStatusTooLarge:       "TOO_LARGE",
+	AppStatusNotImplemented: "NOT_IMPLEMENTED",
+	AppStatusNotFound:       "NOT_FOUND",
+	AppStatusUnavailable:    "UNAVAILABLE",
+	AppStatusUnauthorized:   "UNAUTHORIZED",
+}
+
+// String returns the name of the value as used in the sFlow specification.
+func (v AppStatus) String() string {
+	if name, found := appStatusNames[v]; found {
+		return name
+	}
+
+	return fmt.Sprintf("AppStatus(%d)", uint32(v))
+}
+
+// AppContext identifies the application and operation of a transaction
+type AppContext struct {
+	ApplicationLen uint32
+	Application    []byte `lengthLookUp:"ApplicationLen" maxLength:"32"` /* application name */
+	OperationLen   uint32
+	Operation      []byte `lengthLookUp:"OperationLen" maxLength:"32"` /* operation name */
+	AttributesLen  uint32
+	Attributes     []byte `lengthLookUp:"AttributesLen" maxLength:"255"` /* operation attributes */
+}
+
+func (c AppContext) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(c.ApplicationLen)
+	size += len(c.Application) + xdrPadding(len(c.Application))
+	size += binary.Size(c.OperationLen)
+	size += len(c.Operation) + xdrPadding(len(c.Operation))
+	size += binary.Size(c.AttributesLen)
+	size += len(c.Attributes) + xdrPadding(len(c.Attributes))
+
+	return size
+}
+
+// AppOperationFlow - TypeAppOperationFlowRecord
+type AppOperationFlow struct {
+	Context        AppContext /* attributes of the operation */
+	StatusDescrLen uint32
+	StatusDescr    []byte    `lengthLookUp:"StatusDescrLen" maxLength:"64"` /* additional text describing the status */
+	ReqBytes       uint64    /* size of the request body, excluding headers */
+	RespBytes      uint64    /* size of the response body, excluding headers */
+	Duration       uint32    /* duration of the operation in microseconds */
+	Status         AppStatus /* status code */
+}
+
+func (f AppOperationFlow) String() string {
+	type X AppOperationFlow
+	x := X(f)
+	return fmt.Sprintf("AppOperationFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f AppOperationFlow) RecordName() string {
+	return "AppOperationFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f AppOperationFlow) RecordType() int {
+	return TypeAppOperationFlowRecord
+}
+
+func (f AppOperationFlow) calculateBinarySize() int {
+	var size int
+
+	size += f.Context.calculateBinarySize()
+	size += binary.Size(f.StatusDescrLen)
+	size += len(f.StatusDescr) + xdrPadding(len(f.StatusDescr))
+	size += binary.Size(f.ReqBytes)
+	size += binary.Size(f.RespBytes)
+	size += binary.Size(f.Duration)
+	size += binary.Size(f.Status)
+
+	return size
+}
+
+func (f AppOperationFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// AppParentContextFlow - TypeAppParentContextFlowRecord
+type AppParentContextFlow struct {
+	Context AppContext /* context of the parent operation */
+}
+
+func (f AppParentContextFlow) String() string {
+	type X AppParentContextFlow
+	x := X(f)
+	return fmt.Sprintf("AppParentContextFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f AppParentContextFlow) RecordName() string {
+	return "AppParentContextFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f AppParentContextFlow) RecordType() int {
+	return TypeAppParentContextFlowRecord
+}
+
+func (f AppParentContextFlow) calculateBinarySize() int {
+	var size int
+
+	size += f.Context.calculateBinarySize()
+
+	return size
+}
+
+func (f AppParentContextFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// AppInitiatorFlow - TypeAppInitiatorFlowRecord
+type AppInitiatorFlow struct {
+	ActorLen uint32
+	Actor    []byte `lengthLookUp:"ActorLen" maxLength:"64"` /* business level identifier of the initiator */
+}
+
+func (f AppInitiatorFlow) String() string {
+	type X AppInitiatorFlow
+	x := X(f)
+	return fmt.Sprintf("AppInitiatorFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f AppInitiatorFlow) RecordName() string {
+	return "AppInitiatorFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f AppInitiatorFlow) RecordType() int {
+	return TypeAppInitiatorFlowRecord
+}
+
+func (f AppInitiatorFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.ActorLen)
+	size += len(f.Actor) + xdrPadding(len(f.Actor))
+
+	return size
+}
+
+func (f AppInitiatorFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// AppTargetFlow - TypeAppTargetFlowRecord
+type AppTargetFlow struct {
+	ActorLen uint32
+	Actor    []byte `lengthLookUp:"ActorLen" maxLength:"64"` /* business level identifier of the target */
+}
+
+func (f AppTargetFlow) String() string {
+	type X AppTargetFlow
+	x := X(f)
+	return fmt.Sprintf("AppTargetFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f AppTargetFlow) RecordName() string {
+	return "AppTargetFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f AppTargetFlow) RecordType() int {
+	return TypeAppTargetFlowRecord
+}
+
+func (f AppTargetFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.ActorLen)
+	size += len(f.Actor) + xdrPadding(len(f.Actor))
+
+	return size
+}
+
+func (f AppTargetFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
@@ -0,0 +1,118 @@
+package records
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDecodeApplicationCounterRecords(t *testing.T) {
+	recs := []Record{
+		JVMRuntimeCounter{
+			VMNameLen:    24,
+			VMName:       XDRString("OpenJDK 64-Bit Server VM"),
+			VMVendorLen:  6,
+			VMVendor:     XDRString("Oracle"),
+			VMVersionLen: 8,
+			VMVersion:    XDRString("17.0.2+8"),
+		},
+		JVMStatisticsCounter{HeapInitial: 256 << 20, HeapUsed: 100 << 20, HeapMax: 1 << 30, GCCount: 12, GCTime: 340, ThreadNumLive: 42, FDMaxCount: 4096},
+		MemcacheCounter{CmdSet: 10, GetHits: 90, GetMisses: 10, Threads: 4, CurrItems: 1000, BytesRead: 1 << 33, LimitMaxbytes: 64 << 20},
+		AppOperationsCounter{
+			ApplicationLen: 7,
+			Application:    XDRString("payment"),
+			Success:        1000,
+			Timeout:        3,
+			Unauthorized:   1,
+		},
+		AppResourcesCounter{UserTime: 1200, SystemTime: 300, MemUsed: 512 << 20, MemMax: 1 << 30, FDOpen: 30, FDMax: 1024, ConnOpen: 12, ConnMax: 100},
+		AppWorkersCounter{WorkersActive: 4, WorkersIdle: 12, WorkersMax: 16, ReqDelayed: 2, ReqDropped: 1},
+	}
+
+	for _, rec := range recs {
+		decoded := roundTrip(t, rec)
+
+		// Records survive a JSON round trip
+		j, err := json.Marshal(decoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		unmarshaled := reflect.New(reflect.TypeOf(rec))
+		if err = json.Unmarshal(j, unmarshaled.Interface()); err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(rec, unmarshaled.Elem().Interface()) {
+			t.Errorf("expected\n%+#v\n, got\n%+#v", rec, unmarshaled.Elem().Interface())
+		}
+	}
+}
+
+func TestMarshalJSONAppOperationsCounter(t *testing.T) {
+	rec := AppOperationsCounter{ApplicationLen: 7, Application: XDRString("payment"), Success: 1}
+
+	j, err := json.Marshal(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(j), `"Application":"payment"`) {
+		t.Errorf("unexpected JSON %s", j)
+	}
+}
+
+func TestEncodeDecodeApplicationFlowRecords(t *testing.T) {
+	context := AppContext{
+		ApplicationLen: 7,
+		Application:    []byte("payment"),
+		OperationLen:   9,
+		Operation:      []byte("authorize"),
+		AttributesLen:  13,
+		Attributes:     []byte("currency=EUR&"),
+	}
+
+	recs := []Record{
+		AppOperationFlow{
+			Context:        context,
+			StatusDescrLen: 7,
+			StatusDescr:    []byte("expired"),
+			ReqBytes:       512,
+			RespBytes:      128,
+			Duration:       1500,
+			Status:         AppStatusForbidden,
+		},
+		AppParentContextFlow{Context: context},
+		AppInitiatorFlow{ActorLen: 8, Actor: []byte("customer")},
+		AppTargetFlow{ActorLen: 4, Actor: []byte("bank")},
+		MemcacheOperationFlow{
+			Protocol:   MemcacheProtocolASCII,
+			Cmd:        MemcacheCmdGet,
+			KeyLen:     10,
+			Key:        []byte("session:42"),
+			Nkeys:      1,
+			ValueBytes: 320,
+			Duration:   80,
+			Status:     MemcacheStatusOK,
+		},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+}
+
+func TestApplicationStatusString(t *testing.T) {
+	if s := AppStatusNotImplemented.String(); s != "NOT_IMPLEMENTED" {
+		t.Errorf("expected NOT_IMPLEMENTED, got %s", s)
+	}
+
+	if s := MemcacheCmdTouch.String(); s != "TOUCH" {
+		t.Errorf("expected TOUCH, got %s", s)
+	}
+
+	if s := AppStatus(42).String(); s != "AppStatus(42)" {
+		t.Errorf("expected AppStatus(42), got %s", s)
+	}
+}
@@ -7,61 +7,206 @@ const (
 	TypeIpv4FlowRecord          = 3
 	TypeIpv6FlowRecord          = 4
 
-	TypeExtendedSwitchFlowRecord     = 1001
-	TypeExtendedRouterFlowRecord     = 1002
-	TypeExtendedGatewayFlowRecord    = 1003
-	TypeExtendedUserFlowRecord       = 1004
-	TypeExtendedURLFlowRecord        = 1005
-	TypeExtendedMlpsFlowRecord       = 1006
-	TypeExtendedNatFlowRecord        = 1007
-	TypeExtendedMlpsTunnelFlowRecord = 1008
-	TypeExtendedMlpsVcFlowRecord     = 1009
-	TypeExtendedMlpsFecFlowRecord    = 1010
-	TypeExtendedMlpsLvpFecFlowRecord = 1011
-	TypeExtendedVlanFlowRecord       = 1012
+	TypeExtendedSwitchFlowRecord           = 1001
+	TypeExtendedRouterFlowRecord           = 1002
+	TypeExtendedGatewayFlowRecord          = 1003
+	TypeExtendedUserFlowRecord             = 1004
+	TypeExtendedURLFlowRecord              = 1005
+	TypeExtendedMplsFlowRecord             = 1006
+	TypeExtendedNatFlowRecord              = 1007
+	TypeExtendedMplsTunnelFlowRecord       = 1008
+	TypeExtendedMplsVcFlowRecord           = 1009
+	TypeExtendedMplsFtnFlowRecord          = 1010
+	TypeExtendedMplsLdpFecFlowRecord       = 1011
+	TypeExtendedVlanFlowRecord             = 1012
+	TypeExtended80211PayloadFlowRecord     = 1013
+	TypeExtended80211RxFlowRecord          = 1014
+	TypeExtended80211TxFlowRecord          = 1015
+	TypeExtended80211AggregationFlowRecord = 1016
 
-	TypeExtendedSocketIPv4FlowRecord      = 2100
-	TypeExtendedSocketIPv6FlowRecord      = 2101
-	TypeExtendedProxySocketIPv4FlowRecord = 2102
-	TypeExtendedProxySocketIPv6FlowRecord = 2103
-	TypeHTTPRequestFlowRecord             = 2206
-	TypeHTTPExtendedProxyFlowRecord       = 2207
+	TypeExtendedNatPortFlowRecord            = 1020
+	TypeExtendedL2TunnelEgressFlowRecord     = 1021
+	TypeExtendedL2TunnelIngressFlowRecord    = 1022
+	TypeExtendedIPv4TunnelEgressFlowRecord   = 1023
+	TypeExtendedIPv4TunnelIngressFlowRecord  = 1024
+	TypeExtendedIPv6TunnelEgressFlowRecord   = 1025
+	TypeExtendedIPv6TunnelIngressFlowRecord  = 1026
+	TypeExtendedDecapsulateEgressFlowRecord  = 1027
+	TypeExtendedDecapsulateIngressFlowRecord = 1028
+	TypeExtendedVNIEgressFlowRecord          = 1029
+	TypeExtendedVNIIngressFlowRecord         = 1030
+	TypeExtendedIBLRHFlowRecord              = 1031
+	TypeExtendedIBGRHFlowRecord              = 1032
+	TypeExtendedIBBRHFlowRecord              = 1033
+
+	TypeExtendedEgressQueueFlowRecord     = 1036
+	TypeExtendedFunctionFlowRecord        = 1038
+	TypeExtendedLinuxDropReasonFlowRecord = 1042
+
+	TypeTransactionFlowRecord                    = 2000
+	TypeExtendedNFSStorageTransactionFlowRecord  = 2001
+	TypeExtendedSCSIStorageTransactionFlowRecord = 2002
+	TypeExtendedHTTPTransactionFlowRecord        = 2003
+
+	TypeExtendedSocketIPv4FlowRecord       = 2100
+	TypeExtendedSocketIPv6FlowRecord       = 2101
+	TypeExtendedProxySocketIPv4FlowRecord  = 2102
+	TypeExtendedProxySocketIPv6FlowRecord  = 2103
+	TypeMemcacheOperationFlowRecord        = 2200
+	TypeAppOperationFlowRecord             = 2202
+	TypeAppParentContextFlowRecord         = 2203
+	TypeAppInitiatorFlowRecord             = 2204
+	TypeAppTargetFlowRecord                = 2205
+	TypeHTTPRequestFlowRecord              = 2206
+	TypeHTTPExtendedProxyFlowRecord        = 2207
+	TypeExtendedNavigationTimingFlowRecord = 2208
+)
+
+// Misspelled MPLS flow record types kept for compatibility
+const (
+	// Deprecated: use TypeExtendedMplsFlowRecord
+	TypeExtendedMlpsFlowRecord = TypeExtendedMplsFlowRecord
+	// Deprecated: use TypeExtendedMplsTunnelFlowRecord
+	TypeExtendedMlpsTunnelFlowRecord = TypeExtendedMplsTunnelFlowRecord
+	// Deprecated: use TypeExtendedMplsVcFlowRecord
+	TypeExtendedMlpsVcFlowRecord = TypeExtendedMplsVcFlowRecord
+	// Deprecated: use TypeExtendedMplsFtnFlowRecord
+	TypeExtendedMlpsFecFlowRecord = TypeExtendedMplsFtnFlowRecord
+	// Deprecated: use TypeExtendedMplsLdpFecFlowRecord
+	TypeExtendedMlpsLvpFecFlowRecord = TypeExtendedMplsLdpFecFlowRecord
 )
 
 // flow sample record data structure mapping
 var flowRecordTypes = map[uint32]interface{}{
-	TypeRawPacketFlowRecord:               RawPacketFlow{},
-	TypeEthernetFrameFlowRecord:           EthernetFrameFlow{},
-	TypeExtendedSwitchFlowRecord:          ExtendedSwitchFlow{},
-	TypeExtendedRouterFlowRecord:          ExtendedRouterFlow{},
-	TypeExtendedGatewayFlowRecord:         ExtendedGatewayFlow{},
-	TypeExtendedSocketIPv4FlowRecord:      ExtendedSocketIPv4Flow{},
-	TypeExtendedSocketIPv6FlowRecord:      ExtendedSocketIPv6Flow{},
-	TypeExtendedProxySocketIPv4FlowRecord: ExtendedProxySocketIPv4Flow{},
-	TypeExtendedProxySocketIPv6FlowRecord: ExtendedProxySocketIPv6Flow{},
-	TypeHTTPRequestFlowRecord:             HTTPRequestFlow{},
+	TypeRawPacketFlowRecord:                      RawPacketFlow{},
+	TypeEthernetFrameFlowRecord:                  EthernetFrameFlow{},
+	TypeIpv4FlowRecord:                           SampledIPv4Flow{},
+	TypeIpv6FlowRecord:                           SampledIPv6Flow{},
+	TypeExtendedSwitchFlowRecord:                 ExtendedSwitchFlow{},
+	TypeExtendedRouterFlowRecord:                 ExtendedRouterFlow{},
+	TypeExtendedGatewayFlowRecord:                ExtendedGatewayFlow{},
+	TypeExtendedUserFlowRecord:                   ExtendedUserFlow{},
+	TypeExtendedURLFlowRecord:                    ExtendedURLFlow{},
+	TypeExtendedMplsFlowRecord:                   ExtendedMplsFlow{},
+	TypeExtendedNatFlowRecord:                    ExtendedNatFlow{},
+	TypeExtendedMplsTunnelFlowRecord:             ExtendedMplsTunnelFlow{},
+	TypeExtendedMplsVcFlowRecord:                 ExtendedMplsVcFlow{},
+	TypeExtendedMplsFtnFlowRecord:                ExtendedMplsFtnFlow{},
+	TypeExtendedMplsLdpFecFlowRecord:             ExtendedMplsLdpFecFlow{},
+	TypeExtendedVlanFlowRecord:                   ExtendedVlanTunnelFlow{},
+	TypeExtended80211PayloadFlowRecord:           Extended80211PayloadFlow{},
+	TypeExtended80211RxFlowRecord:                Extended80211RxFlow{},
+	TypeExtended80211TxFlowRecord:                Extended80211TxFlow{},
+	TypeExtended80211AggregationFlowRecord:       Extended80211AggregationFlow{},
+	TypeExtendedNatPortFlowRecord:                ExtendedNatPortFlow{},
+	TypeExtendedL2TunnelEgressFlowRecord:         ExtendedL2TunnelEgressFlow{},
+	TypeExtendedL2TunnelIngressFlowRecord:        ExtendedL2TunnelIngressFlow{},
+	TypeExtendedIPv4TunnelEgressFlowRecord:       ExtendedIPv4TunnelEgressFlow{},
+	TypeExtendedIPv4TunnelIngressFlowRecord:      ExtendedIPv4TunnelIngressFlow{},
+	TypeExtendedIPv6TunnelEgressFlowRecord:       ExtendedIPv6TunnelEgressFlow{},
+	TypeExtendedIPv6TunnelIngressFlowRecord:      ExtendedIPv6TunnelIngressFlow{},
+	TypeExtendedDecapsulateEgressFlowRecord:      ExtendedDecapsulateEgressFlow{},
+	TypeExtendedDecapsulateIngressFlowRecord:     ExtendedDecapsulateIngressFlow{},
+	TypeExtendedVNIEgressFlowRecord:              ExtendedVNIEgressFlow{},
+	TypeExtendedVNIIngressFlowRecord:             ExtendedVNIIngressFlow{},
+	TypeExtendedIBLRHFlowRecord:                  ExtendedIBLRHFlow{},
+	TypeExtendedIBGRHFlowRecord:                  ExtendedIBGRHFlow{},
+	TypeExtendedIBBRHFlowRecord:                  ExtendedIBBRHFlow{},
+	TypeExtendedEgressQueueFlowRecord:            ExtendedEgressQueueFlow{},
+	TypeExtendedFunctionFlowRecord:               ExtendedFunctionFlow{},
+	TypeExtendedLinuxDropReasonFlowRecord:        ExtendedLinuxDropReasonFlow{},
+	TypeTransactionFlowRecord:                    TransactionFlow{},
+	TypeExtendedNFSStorageTransactionFlowRecord:  ExtendedNFSStorageTransactionFlow{},
+	TypeExtendedSCSIStorageTransactionFlowRecord: ExtendedSCSIStorageTransactionFlow{},
+	TypeExtendedHTTPTransactionFlowRecord:        ExtendedHTTPTransactionFlow{},
+	TypeExtendedSocketIPv4FlowRecord:             ExtendedSocketIPv4Flow{},
+	TypeExtendedSocketIPv6FlowRecord:             ExtendedSocketIPv6Flow{},
+	TypeExtendedProxySocketIPv4FlowRecord:        ExtendedProxySocketIPv4Flow{},
+	TypeExtendedProxySocketIPv6FlowRecord:        ExtendedProxySocketIPv6Flow{},
+	TypeMemcacheOperationFlowRecord:              MemcacheOperationFlow{},
+	TypeAppOperationFlowRecord:                   AppOperationFlow{},
+	TypeAppParentContextFlowRecord:               AppParentContextFlow{},
+	TypeAppInitiatorFlowRecord:                   AppInitiatorFlow{},
+	TypeAppTargetFlowRecord:                      AppTargetFlow{},
+	TypeHTTPRequestFlowRecord:                    HTTPRequestFlow{},
+	TypeHTTPExtendedProxyFlowRecord:              ExtendedProxyRequestFlow{},
+	TypeExtendedNavigationTimingFlowRecord:       ExtendedNavigationTimingFlow{},
 }
 
 // sflow counter record types
 const (
-	TypeHostDescriptionCounterRecord = 2000
-	TypeHTTPCounterRecord            = 2201
+	TypeIEEE80211CounterRecord        = 6
+	TypeIBCounterRecord               = 9
+	TypeRadioUtilizationCounterRecord = 1002
+	TypeOFPortCounterRecord           = 1004
+	TypePortNameCounterRecord         = 1005
+	TypeHostDescriptionCounterRecord  = 2000
+	TypeHostAdaptersCounterRecord     = 2001
+	TypeHostParentCounterRecord       = 2002
+	TypeMIB2IPGroupCounterRecord      = 2007
+	TypeMIB2ICMPGroupCounterRecord    = 2008
+	TypeMIB2TCPGroupCounterRecord     = 2009
+	TypeMIB2UDPGroupCounterRecord     = 2010
+	TypeVirtNodeCounterRecord         = 2100
+	TypeVirtCPUCounterRecord          = 2101
+	TypeVirtMemoryCounterRecord       = 2102
+	TypeVirtDiskIOCounterRecord       = 2103
+	TypeVirtNetIOCounterRecord        = 2104
+	TypeJVMRuntimeCounterRecord       = 2105
+	TypeJVMStatisticsCounterRecord    = 2106
+	TypeHTTPCounterRecord             = 2201
+	TypeAppOperationsCounterRecord    = 2202
+	TypeAppResourcesCounterRecord     = 2203
+	TypeMemcacheCounterRecord         = 2204
+	TypeAppWorkersCounterRecord       = 2206
+	TypeOVSDPStatsCounterRecord       = 2207
 )
 
 // counter sample record data structure mapping
 var counterRecordTypes = map[uint32]interface{}{
-	TypeHTTPCounterRecord: HTTPCounter{},
-	//TypeHostDescriptionCounterRecord: HostDescriptionCounter{},
-
+	TypeIEEE80211CounterRecord:        IEEE80211Counter{},
+	TypeIBCounterRecord:               IBCounter{},
+	TypeRadioUtilizationCounterRecord: RadioUtilizationCounter{},
+	TypeOFPortCounterRecord:           OFPortCounter{},
+	TypePortNameCounterRecord:         PortNameCounter{},
+	TypeHostDescriptionCounterRecord:  HostDescriptionCounter{},
+	TypeHostAdaptersCounterRecord:     HostAdapters{},
+	TypeHostParentCounterRecord:       HostParent{},
+	TypeMIB2IPGroupCounterRecord:      MIB2IPGroupCounter{},
+	TypeMIB2ICMPGroupCounterRecord:    MIB2ICMPGroupCounter{},
+	TypeMIB2TCPGroupCounterRecord:     MIB2TCPGroupCounter{},
+	TypeMIB2UDPGroupCounterRecord:     MIB2UDPGroupCounter{},
+	TypeVirtNodeCounterRecord:         VirtNodeCounter{},
+	TypeVirtCPUCounterRecord:          VirtCPUCounter{},
+	TypeVirtMemoryCounterRecord:       VirtMemoryCounter{},
+	TypeVirtDiskIOCounterRecord:       VirtDiskIOCounter{},
+	TypeVirtNetIOCounterRecord:        VirtNetIOCounter{},
+	TypeJVMRuntimeCounterRecord:       JVMRuntimeCounter{},
+	TypeJVMStatisticsCounterRecord:    JVMStatisticsCounter{},
+	TypeHTTPCounterRecord:             HTTPCounter{},
+	TypeAppOperationsCounterRecord:    AppOperationsCounter{},
+	TypeAppResourcesCounterRecord:     AppResourcesCounter{},
+	TypeMemcacheCounterRecord:         MemcacheCounter{},
+	TypeAppWorkersCounterRecord:       AppWorkersCounter{},
+	TypeOVSDPStatsCounterRecord:       OVSDPStatsCounter{},
 }
 
 // IP Header Protocol Types (see: https://en.wikipedia.org/wiki/List_of_IP_protocol_numbers)
 const (
-	IPProtocolICMP = 1
-	IPProtocolTCP  = 6
-	IPProtocolUDP  = 17
-	IPProtocolESP  = 50 // IPSEC
-	IPProtocolAH   = 51 // IPSEC
+	IPProtocolHopByHop           = 0 // IPv6 extension header
+	IPProtocolICMP               = 1
+	IPProtocolIPIP               = 4 // IPv4 encapsulation
+	IPProtocolTCP                = 6
+	IPProtocolUDP                = 17
+	IPProtocolIPv6Encapsulation  = 41
+	IPProtocolRouting            = 43 // IPv6 extension header
+	IPProtocolFragment           = 44 // IPv6 extension header
+	IPProtocolGRE                = 47
+	IPProtocolESP                = 50 // IPSEC
+	IPProtocolAH                 = 51 // IPSEC
+	IPProtocolICMPv6             = 58
+	IPProtocolNoNextHeader       = 59 // IPv6
+	IPProtocolDestinationOptions = 60 // IPv6 extension header
 )
 
 const (
@@ -79,7 +224,9 @@ const (
 
 	// MinimumEthernetHeaderSize defines the minimum header size to be parsed
 	MinimumEthernetHeaderSize = 14
-	//#define NFT_8022_SIZ 3
-	//#define NFT_MAX_8023_LEN 1500
+
+	// MaximumIEEE8023Length defines the largest value of the Ethernet type field
+	// that is an 802.3 length rather than an EtherType.
+	MaximumIEEE8023Length = 1500
 	//#define NFT_MIN_SIZ (NFT_ETHHDR_SIZ + sizeof(struct myiphdr))
 )
@@ -0,0 +1,873 @@
+package records
+
+import (
+	"encoding/binary"
+	"net"
+)
+
+// DecodedPacket is the typed, layered representation of RawPacketFlow.Header.
+// Layers not present in the sampled header are nil.
+type DecodedPacket struct {
+	Ethernet             *EthernetHeader
+	VLANs                []VLANHeader
+	LLC                  *LLCHeader
+	SNAP                 *SNAPHeader
+	EtherType            uint16 // EtherType following the Ethernet header, VLAN tags or SNAP header
+	ARP                  *ARPHeader
+	LLDP                 *LLDPHeader
+	MPLSLabels           []MPLSLabel
+	IPv4                 *IPv4Header
+	IPv6                 *IPv6Header
+	IPv6ExtensionHeaders []IPv6ExtensionHeader
+	TCP                  *TCPHeader
+	UDP                  *UDPHeader
+	ICMP                 *ICMPHeader // ICMP for IPv4, ICMPv6 for IPv6
+	IBLRH                *IBLocalRouteHeader
+	IBGRH                *IBGlobalRouteHeader
+	IBBTH                *IBBaseTransportHeader
+	Tunnel               *TunnelHeader
+	Inner                *DecodedPacket // encapsulated packet described by Tunnel
+
+	depth         int
+	protocol      uint8
+	payloadOffset int
+	truncated     bool
+
+	// Backing storage for the layers, so decoding a packet needs a single allocation.
+	ethernet EthernetHeader
+	vlans    [2]VLANHeader
+	llc      LLCHeader
+	snap     SNAPHeader
+	arp      ARPHeader
+	lldp     LLDPHeader
+	mpls     [4]MPLSLabel
+	ipv4     IPv4Header
+	ipv6     IPv6Header
+	ipv6Ext  [2]IPv6ExtensionHeader
+	tcp      TCPHeader
+	udp      UDPHeader
+	icmp     ICMPHeader
+	ibLRH    IBLocalRouteHeader
+	ibGRH    IBGlobalRouteHeader
+	ibBTH    IBBaseTransportHeader
+	tunnel   TunnelHeader
+}
+
+// maxTunnelDepth limits the number of nested encapsulations decoded.
+const maxTunnelDepth = 4
+
+// UDP ports of tunnel protocols
+const (
+	UDPPortVXLAN  = 4789
+	UDPPortGeneve = 6081
+)
+
+// Tunnel Types found in TunnelHeader
+const (
+	TunnelTypeVXLAN  = 1
+	TunnelTypeGeneve = 2
+	TunnelTypeGRE    = 3
+	TunnelTypeIPinIP = 4
+)
+
+// TunnelHeader describes the encapsulation between the outer layers of a DecodedPacket and its Inner packet.
+type TunnelHeader struct {
+	Type       int
+	Protocol   uint16 // EtherType of the encapsulated packet
+	VNI        uint32 // VXLAN or Geneve VNI, GRE key
+	VNIPresent bool   // whether VNI was present in the tunnel header
+}
+
+// FiveTuple identifies the flow a packet belongs to.
+type FiveTuple struct {
+	SrcAddr  net.IP
+	DstAddr  net.IP
+	Protocol uint8
+	SrcPort  uint16
+	DstPort  uint16
+}
+
+// FiveTuple returns the addresses, upper-layer protocol and ports of the packet.
+// ok is false if the packet has no IP layer. Ports are zero for protocols without ports.
+func (p *DecodedPacket) FiveTuple() (t FiveTuple, ok bool) {
+	switch {
+	case p.IPv4 != nil:
+		t.SrcAddr, t.DstAddr = p.IPv4.SrcAddr, p.IPv4.DstAddr
+	case p.IPv6 != nil:
+		t.SrcAddr, t.DstAddr = p.IPv6.SrcAddr, p.IPv6.DstAddr
+	default:
+		return t, false
+	}
+
+	t.Protocol = p.protocol
+
+	switch {
+	case p.TCP != nil:
+		t.SrcPort, t.DstPort = p.TCP.SrcPort, p.TCP.DstPort
+	case p.UDP != nil:
+		t.SrcPort, t.DstPort = p.UDP.SrcPort, p.UDP.DstPort
+	}
+
+	return t, true
+}
+
+// Innermost returns the most deeply encapsulated packet, or p itself if it is not tunneled.
+func (p *DecodedPacket) Innermost() *DecodedPacket {
+	for p.Inner != nil {
+		p = p.Inner
+	}
+
+	return p
+}
+
+// PayloadOffset returns the offset of the first byte after the last decoded layer,
+// i.e. the start of the L4 payload for TCP, UDP and ICMP packets.
+func (p *DecodedPacket) PayloadOffset() int {
+	return p.payloadOffset
+}
+
+// Truncated reports whether the sampled header ended before a layer could be decoded completely.
+func (p *DecodedPacket) Truncated() bool {
+	return p.truncated
+}
+
+// headerMap returns the layers keyed as in RawPacketFlow.HeaderMap.
+func (p *DecodedPacket) headerMap() map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if p.Ethernet != nil {
+		m["ethernet"] = *p.Ethernet
+	}
+	if len(p.VLANs) > 0 {
+		m["vlan"] = p.VLANs
+	}
+	if p.LLC != nil {
+		m["llc"] = *p.LLC
+	}
+	if p.SNAP != nil {
+		m["snap"] = *p.SNAP
+	}
+	if p.ARP != nil {
+		m["arp"] = *p.ARP
+	}
+	if p.LLDP != nil {
+		m["lldp"] = *p.LLDP
+	}
+	if len(p.MPLSLabels) > 0 {
+		m["mpls"] = p.MPLSLabels
+	}
+	if p.IPv4 != nil {
+		m["ip"] = *p.IPv4
+	}
+	if p.IPv6 != nil {
+		m["ip"] = *p.IPv6
+	}
+	if len(p.IPv6ExtensionHeaders) > 0 {
+		m["ipv6ExtensionHeaders"] = p.IPv6ExtensionHeaders
+	}
+	if p.TCP != nil {
+		m["tcp"] = *p.TCP
+	}
+	if p.UDP != nil {
+		m["udp"] = *p.UDP
+	}
+	if p.ICMP != nil {
+		if p.IPv6 != nil {
+			m["icmpv6"] = *p.ICMP
+		} else {
+			m["icmp"] = *p.ICMP
+		}
+	}
+	if p.IBLRH != nil {
+		m["ib_lrh"] = *p.IBLRH
+	}
+	if p.IBGRH != nil {
+		m["ib_grh"] = *p.IBGRH
+	}
+	if p.IBBTH != nil {
+		m["ib_bth"] = *p.IBBTH
+	}
+	if p.Tunnel != nil {
+		m["tunnel"] = *p.Tunnel
+	}
+	if p.Inner != nil {
+		m["inner"] = p.Inner.headerMap()
+	}
+
+	return m
+}
+
+// DecodePacket decodes the layers of a sampled header of the given header protocol.
+// The returned layers reference b, which must not be modified afterwards.
+func DecodePacket(headerProtocol uint32, b []byte) *DecodedPacket {
+	p := &DecodedPacket{}
+
+	switch headerProtocol {
+	case HeaderProtocolEthernetISO8023:
+		p.decodeEthernet(b, 0)
+	case HeaderProtocolIPv4:
+		p.decodeIPv4(b, 0)
+	case HeaderProtocolIPv6:
+		p.decodeIPv6(b, 0)
+	case HeaderProtocolMPLS:
+		p.decodeMPLS(b, 0)
+	case HeaderProtocolInfiniBand:
+		p.decodeIBLocalRoute(b, 0)
+	}
+
+	return p
+}
+
+// truncate marks the packet as truncated at the layer starting at off.
+func (p *DecodedPacket) truncate(off int) {
+	p.truncated = true
+	p.payloadOffset = off
+}
+
+func (p *DecodedPacket) decodeEthernet(b []byte, off int) {
+	if len(b) < off+MinimumEthernetHeaderSize {
+		p.truncate(off)
+		return
+	}
+
+	p.ethernet = EthernetHeader{
+		DstMac: HardwareAddr(b[off : off+6]),
+		SrcMac: HardwareAddr(b[off+6 : off+12]),
+	}
+	p.Ethernet = &p.ethernet
+
+	etherType := binary.BigEndian.Uint16(b[off+12:])
+	off += MinimumEthernetHeaderSize
+
+	// Skip over any number of VLAN tags, the inner EtherType follows the last one
+	p.VLANs = p.vlans[:0]
+	for isVLANEtherType(etherType) {
+		if len(b) < off+4 {
+			p.truncate(off)
+			return
+		}
+
+		tci := binary.BigEndian.Uint16(b[off:])
+		p.VLANs = append(p.VLANs, VLANHeader{
+			TPID: etherType,
+			PCP:  uint8(tci >> 13),
+			DEI:  tci&0x1000 != 0,
+			VID:  tci & 0x0fff,
+		})
+
+		etherType = binary.BigEndian.Uint16(b[off+2:])
+		off += 4
+	}
+	if len(p.VLANs) == 0 {
+		p.VLANs = nil
+	}
+
+	// 802.3 frames carry a length instead of an EtherType and start with an LLC header
+	if etherType <= MaximumIEEE8023Length {
+		p.decodeLLC(b, off)
+		return
+	}
+
+	p.decodeEtherType(etherType, b, off)
+}
+
+func (p *DecodedPacket) decodeEtherType(etherType uint16, b []byte, off int) {
+	p.EtherType = etherType
+
+	switch etherType {
+	case EtherTypeIPv4:
+		p.decodeIPv4(b, off)
+	case EtherTypeIPv6:
+		p.decodeIPv6(b, off)
+	case EtherTypeMPLS, EtherTypeMPLSMulti:
+		p.decodeMPLS(b, off)
+	case EtherTypeARP:
+		p.decodeARP(b, off)
+	case EtherTypeLLDP:
+		p.decodeLLDP(b, off)
+	default:
+		p.payloadOffset = off
+	}
+}
+
+func (p *DecodedPacket) decodeLLC(b []byte, off int) {
+	if len(b) < off+3 {
+		p.truncate(off)
+		return
+	}
+
+	p.llc = LLCHeader{
+		DSAP:    b[off],
+		SSAP:    b[off+1],
+		Control: uint16(b[off+2]),
+	}
+	p.LLC = &p.llc
+
+	// Information and supervisory frames have a two octet control field
+	if p.llc.Control&0x03 != 0x03 {
+		if len(b) < off+4 {
+			p.truncate(off)
+			return
+		}
+
+		p.llc.Control = binary.BigEndian.Uint16(b[off+2:])
+		p.payloadOffset = off + 4
+		return
+	}
+	off += 3
+
+	if p.llc.DSAP != 0xaa || p.llc.SSAP != 0xaa {
+		p.payloadOffset = off
+		return
+	}
+
+	if len(b) < off+5 {
+		p.truncate(off)
+		return
+	}
+
+	p.snap = SNAPHeader{
+		OUI:      [3]byte{b[off], b[off+1], b[off+2]},
+		Protocol: binary.BigEndian.Uint16(b[off+3:]),
+	}
+	p.SNAP = &p.snap
+	off += 5
+
+	// An OUI of zero means the protocol is an EtherType
+	if p.snap.OUI != [3]byte{} {
+		p.payloadOffset = off
+		return
+	}
+
+	p.decodeEtherType(p.snap.Protocol, b, off)
+}
+
+func (p *DecodedPacket) decodeARP(b []byte, off int) {
+	if len(b) < off+8 {
+		p.truncate(off)
+		return
+	}
+
+	p.arp = ARPHeader{
+		HardwareType: binary.BigEndian.Uint16(b[off:]),
+		ProtocolType: binary.BigEndian.Uint16(b[off+2:]),
+		HardwareLen:  b[off+4],
+		ProtocolLen:  b[off+5],
+		Operation:    binary.BigEndian.Uint16(b[off+6:]),
+	}
+	p.ARP = &p.arp
+
+	hlen, plen := int(p.arp.HardwareLen), int(p.arp.ProtocolLen)
+	if len(b) < off+8+2*(hlen+plen) {
+		p.truncate(off + 8)
+		return
+	}
+	off += 8
+
+	p.arp.SenderHardwareAddr = HardwareAddr(b[off : off+hlen])
+	off += hlen
+	p.arp.SenderProtocolAddr = net.IP(b[off : off+plen])
+	off += plen
+	p.arp.TargetHardwareAddr = HardwareAddr(b[off : off+hlen])
+	off += hlen
+	p.arp.TargetProtocolAddr = net.IP(b[off : off+plen])
+	off += plen
+
+	p.payloadOffset = off
+}
+
+func (p *DecodedPacket) decodeLLDP(b []byte, off int) {
+	p.LLDP = &p.lldp
+
+	for {
+		// 7 bit type, 9 bit length
+		if len(b) < off+2 {
+			p.truncate(off)
+			return
+		}
+
+		typeAndLength := binary.BigEndian.Uint16(b[off:])
+		tlv := LLDPTLV{Type: uint8(typeAndLength >> 9)}
+		length := int(typeAndLength & 0x01ff)
+
+		if tlv.Type == LLDPTLVTypeEnd {
+			p.payloadOffset = off + 2
+			return
+		}
+
+		if len(b) < off+2+length {
+			p.truncate(off)
+			return
+		}
+
+		tlv.Value = b[off+2 : off+2+length]
+		p.lldp.TLVs = append(p.lldp.TLVs, tlv)
+		off += 2 + length
+
+		switch tlv.Type {
+		case LLDPTLVTypeChassisID:
+			if length > 0 {
+				p.lldp.ChassisIDSubtype = tlv.Value[0]
+				p.lldp.ChassisID = tlv.Value[1:]
+			}
+		case LLDPTLVTypePortID:
+			if length > 0 {
+				p.lldp.PortIDSubtype = tlv.Value[0]
+				p.lldp.PortID = tlv.Value[1:]
+			}
+		case LLDPTLVTypeTTL:
+			if length >= 2 {
+				p.lldp.TTL = binary.BigEndian.Uint16(tlv.Value)
+			}
+		case LLDPTLVTypePortDescription:
+			p.lldp.PortDescription = tlv.Value
+		case LLDPTLVTypeSystemName:
+			p.lldp.SystemName = tlv.Value
+		case LLDPTLVTypeSystemDescription:
+			p.lldp.SystemDescription = tlv.Value
+		}
+	}
+}
+
+func (p *DecodedPacket) decodeMPLS(b []byte, off int) {
+	p.MPLSLabels = p.mpls[:0]
+
+	for {
+		if len(b) < off+4 {
+			p.truncate(off)
+			return
+		}
+
+		entry := binary.BigEndian.Uint32(b[off:])
+		label := MPLSLabel{
+			Label:         entry >> 12,
+			TC:            uint8(entry>>9) & 0x07,
+			BottomOfStack: entry&0x100 != 0,
+			TTL:           uint8(entry),
+		}
+		p.MPLSLabels = append(p.MPLSLabels, label)
+		off += 4
+
+		if label.BottomOfStack {
+			break
+		}
+	}
+
+	// The payload type is not encoded in the label stack, guess it from the IP version
+	if len(b) < off+1 {
+		p.truncate(off)
+		return
+	}
+
+	switch b[off] >> 4 {
+	case 4:
+		p.decodeIPv4(b, off)
+	case 6:
+		p.decodeIPv6(b, off)
+	default:
+		p.payloadOffset = off
+	}
+}
+
+func (p *DecodedPacket) decodeIPv4(b []byte, off int) {
+	if len(b) < off+20 {
+		p.truncate(off)
+		return
+	}
+
+	p.ipv4 = IPv4Header{
+		VersionAndLen: b[off],
+		Tos:           b[off+1],
+		TotLen:        binary.BigEndian.Uint16(b[off+2:]),
+		ID:            binary.BigEndian.Uint16(b[off+4:]),
+		FragOff:       binary.BigEndian.Uint16(b[off+6:]),
+		TTL:           b[off+8],
+		Protocol:      b[off+9],
+		Check:         binary.BigEndian.Uint16(b[off+10:]),
+		SrcAddr:       net.IP(b[off+12 : off+16]),
+		DstAddr:       net.IP(b[off+16 : off+20]),
+	}
+	p.IPv4 = &p.ipv4
+	p.protocol = p.ipv4.Protocol
+
+	// The header length includes options
+	headerLength := int(p.ipv4.VersionAndLen&0x0f) * 4
+	if headerLength < 20 || len(b) < off+headerLength {
+		p.truncate(off + 20)
+		return
+	}
+	off += headerLength
+
+	// Only the first fragment carries the upper-layer header
+	if p.ipv4.FragOff&0x1fff != 0 {
+		p.payloadOffset = off
+		return
+	}
+
+	p.decodeTransport(p.ipv4.Protocol, b, off)
+}
+
+func (p *DecodedPacket) decodeIPv6(b []byte, off int) {
+	if len(b) < off+40 {
+		p.truncate(off)
+		return
+	}
+
+	p.ipv6 = IPv6Header{
+		VersionAndPriority: b[off],
+		Label1:             b[off+1],
+		Label2:             b[off+2],
+		Label3:             b[off+3],
+		PayloadLength:      binary.BigEndian.Uint16(b[off+4:]),
+		NextHeader:         b[off+6],
+		TTL:                b[off+7],
+		SrcAddr:            net.IP(b[off+8 : off+24]),
+		DstAddr:            net.IP(b[off+24 : off+40]),
+	}
+	p.IPv6 = &p.ipv6
+	off += 40
+
+	// Walk the extension header chain to find the upper-layer protocol
+	nextHeader := p.ipv6.NextHeader
+	p.IPv6ExtensionHeaders = p.ipv6Ext[:0]
+
+	for isIPv6ExtensionHeader(nextHeader) {
+		ext, ok := decodeIPv6ExtensionHeader(nextHeader, b[off:])
+		if !ok {
+			p.protocol = nextHeader
+			p.truncate(off)
+			return
+		}
+
+		p.IPv6ExtensionHeaders = append(p.IPv6ExtensionHeaders, ext)
+		nextHeader = ext.NextHeader
+		off += ext.Length
+
+		// Only the first fragment carries the upper-layer header
+		if ext.Type == IPProtocolFragment && ext.FragmentOffset != 0 {
+			p.protocol = nextHeader
+			p.payloadOffset = off
+			return
+		}
+	}
+	if len(p.IPv6ExtensionHeaders) == 0 {
+		p.IPv6ExtensionHeaders = nil
+	}
+
+	p.protocol = nextHeader
+	p.decodeTransport(nextHeader, b, off)
+}
+
+// decodeTransport decodes the Layer4 Protocol Header following an IP header.
+func (p *DecodedPacket) decodeTransport(protocol uint8, b []byte, off int) {
+	// See https://en.wikipedia.org/wiki/List_of_IP_protocol_numbers
+	switch protocol {
+	case IPProtocolTCP:
+		if len(b) < off+20 {
+			p.truncate(off)
+			return
+		}
+
+		p.tcp = TCPHeader{
+			SrcPort:  binary.BigEndian.Uint16(b[off:]),
+			DstPort:  binary.BigEndian.Uint16(b[off+2:]),
+			Seq:      binary.BigEndian.Uint32(b[off+4:]),
+			Ack:      binary.BigEndian.Uint32(b[off+8:]),
+			UnUsed:   b[off+12],
+			Flags:    b[off+13],
+			Window:   binary.BigEndian.Uint16(b[off+14:]),
+			Checksum: binary.BigEndian.Uint16(b[off+16:]),
+			Urgent:   binary.BigEndian.Uint16(b[off+18:]),
+		}
+		p.TCP = &p.tcp
+
+		// The data offset includes options
+		dataOffset := int(p.tcp.UnUsed>>4) * 4
+		if dataOffset < 20 || len(b) < off+dataOffset {
+			p.truncate(off + 20)
+			return
+		}
+		p.payloadOffset = off + dataOffset
+	case IPProtocolUDP:
+		if len(b) < off+8 {
+			p.truncate(off)
+			return
+		}
+
+		p.udp = UDPHeader{
+			SrcPort:  binary.BigEndian.Uint16(b[off:]),
+			DstPort:  binary.BigEndian.Uint16(b[off+2:]),
+			Length:   binary.BigEndian.Uint16(b[off+4:]),
+			Checksum: binary.BigEndian.Uint16(b[off+6:]),
+		}
+		p.UDP = &p.udp
+		p.payloadOffset = off + 8
+
+		switch p.udp.DstPort {
+		case UDPPortVXLAN:
+			p.decodeVXLAN(b, off+8)
+		case UDPPortGeneve:
+			p.decodeGeneve(b, off+8)
+		}
+	case IPProtocolICMP, IPProtocolICMPv6:
+		// Type, code and checksum
+		if len(b) < off+4 {
+			p.truncate(off)
+			return
+		}
+
+		p.icmp = ICMPHeader{
+			Type: b[off],
+			Code: b[off+1],
+		}
+		p.ICMP = &p.icmp
+		p.payloadOffset = off + 4
+	case IPProtocolGRE:
+		p.payloadOffset = off
+		p.decodeGRE(b, off)
+	case IPProtocolIPIP:
+		p.payloadOffset = off
+		p.decodeInner(TunnelHeader{Type: TunnelTypeIPinIP, Protocol: EtherTypeIPv4}, b, off)
+	case IPProtocolIPv6Encapsulation:
+		p.payloadOffset = off
+		p.decodeInner(TunnelHeader{Type: TunnelTypeIPinIP, Protocol: EtherTypeIPv6}, b, off)
+	default:
+		p.payloadOffset = off
+	}
+}
+
+func (p *DecodedPacket) decodeVXLAN(b []byte, off int) {
+	// Flags, reserved, VNI, reserved
+	if len(b) < off+8 {
+		return
+	}
+
+	// The I flag marks a valid VNI
+	if b[off]&0x08 == 0 {
+		return
+	}
+
+	tunnel := TunnelHeader{
+		Type:       TunnelTypeVXLAN,
+		Protocol:   EtherTypeTEB,
+		VNI:        binary.BigEndian.Uint32(b[off+4:]) >> 8,
+		VNIPresent: true,
+	}
+
+	p.decodeInner(tunnel, b, off+8)
+}
+
+func (p *DecodedPacket) decodeGeneve(b []byte, off int) {
+	// Version and options length, flags, protocol type, VNI, reserved
+	if len(b) < off+8 {
+		return
+	}
+
+	// Only version 0 is defined
+	if b[off]>>6 != 0 {
+		return
+	}
+
+	tunnel := TunnelHeader{
+		Type:       TunnelTypeGeneve,
+		Protocol:   binary.BigEndian.Uint16(b[off+2:]),
+		VNI:        binary.BigEndian.Uint32(b[off+4:]) >> 8,
+		VNIPresent: true,
+	}
+
+	// Options length is in 4-octet units
+	p.decodeInner(tunnel, b, off+8+int(b[off]&0x3f)*4)
+}
+
+func (p *DecodedPacket) decodeGRE(b []byte, off int) {
+	// Flags and version, protocol type
+	if len(b) < off+4 {
+		return
+	}
+
+	flags := binary.BigEndian.Uint16(b[off:])
+	tunnel := TunnelHeader{
+		Type:     TunnelTypeGRE,
+		Protocol: binary.BigEndian.Uint16(b[off+2:]),
+	}
+	off += 4
+
+	// Only version 0 is decoded, version 1 is PPTP
+	if flags&0x0007 != 0 {
+		return
+	}
+
+	// Checksum present
+	if flags&0x8000 != 0 {
+		off += 4
+	}
+
+	// Key present, NVGRE carries the VSID in the upper 24 bits
+	if flags&0x2000 != 0 {
+		if len(b) < off+4 {
+			return
+		}
+
+		tunnel.VNI = binary.BigEndian.Uint32(b[off:])
+		tunnel.VNIPresent = true
+		off += 4
+	}
+
+	// Sequence number present
+	if flags&0x1000 != 0 {
+		off += 4
+	}
+
+	p.decodeInner(tunnel, b, off)
+}
+
+// decodeInner decodes the packet encapsulated in tunnel starting at off.
+func (p *DecodedPacket) decodeInner(tunnel TunnelHeader, b []byte, off int) {
+	if p.depth >= maxTunnelDepth {
+		return
+	}
+
+	p.tunnel = tunnel
+	p.Tunnel = &p.tunnel
+
+	inner := &DecodedPacket{depth: p.depth + 1}
+
+	switch tunnel.Protocol {
+	case EtherTypeTEB:
+		inner.decodeEthernet(b, off)
+	case EtherTypeIPv4:
+		inner.decodeIPv4(b, off)
+	case EtherTypeIPv6:
+		inner.decodeIPv6(b, off)
+	case EtherTypeMPLS, EtherTypeMPLSMulti:
+		inner.decodeMPLS(b, off)
+	default:
+		return
+	}
+
+	p.Inner = inner
+}
+
+func isVLANEtherType(etherType uint16) bool {
+	switch etherType {
+	case EtherTypeVLAN, EtherTypeQinQ, EtherTypeQinQLegacy:
+		return true
+	}
+
+	return false
+}
+
+func isIPv6ExtensionHeader(protocol uint8) bool {
+	switch protocol {
+	case IPProtocolHopByHop, IPProtocolRouting, IPProtocolFragment,
+		IPProtocolDestinationOptions, IPProtocolAH:
+		return true
+	}
+
+	return false
+}
+
+// decodeIPv6ExtensionHeader decodes the IPv6 extension header of the given type at the start of b.
+// ok is false if b is shorter than the extension header.
+func decodeIPv6ExtensionHeader(headerType uint8, b []byte) (ext IPv6ExtensionHeader, ok bool) {
+	if len(b) < 8 {
+		return ext, false
+	}
+
+	ext.Type = headerType
+	ext.NextHeader = b[0]
+
+	switch headerType {
+	case IPProtocolFragment:
+		// Fragment headers have a fixed size of 8 octets
+		ext.Length = 8
+		ext.FragmentOffset = binary.BigEndian.Uint16(b[2:]) >> 3
+	case IPProtocolAH:
+		// Length is in 4-octet units, minus 2
+		ext.Length = (int(b[1]) + 2) * 4
+	default:
+		// Length is in 8-octet units, not including the first 8 octets
+		ext.Length = (int(b[1]) + 1) * 8
+	}
+
+	return ext, len(b) >= ext.Length
+}
+
+func (p *DecodedPacket) decodeIBLocalRoute(b []byte, off int) {
+	if len(b) < off+8 {
+		p.truncate(off)
+		return
+	}
+
+	p.ibLRH = IBLocalRouteHeader{
+		VL:             b[off] >> 4,
+		LinkVersion:    b[off] & 0x0f,
+		SL:             b[off+1] >> 4,
+		LinkNextHeader: b[off+1] & 0x03,
+		DLID:           binary.BigEndian.Uint16(b[off+2:]),
+		PacketLength:   binary.BigEndian.Uint16(b[off+4:]) & 0x07ff,
+		SLID:           binary.BigEndian.Uint16(b[off+6:]),
+	}
+	p.IBLRH = &p.ibLRH
+	off += 8
+
+	switch p.ibLRH.LinkNextHeader {
+	case IBLinkNextHeaderIPv6:
+		p.decodeIPv6(b, off)
+	case IBLinkNextHeaderLocal:
+		p.decodeIBBaseTransport(b, off)
+	case IBLinkNextHeaderGlobal:
+		p.decodeIBGlobalRoute(b, off)
+	default:
+		p.payloadOffset = off
+	}
+}
+
+func (p *DecodedPacket) decodeIBGlobalRoute(b []byte, off int) {
+	if len(b) < off+40 {
+		p.truncate(off)
+		return
+	}
+
+	versionClassLabel := binary.BigEndian.Uint32(b[off:])
+	p.ibGRH = IBGlobalRouteHeader{
+		TrafficClass:  uint8(versionClassLabel >> 20),
+		FlowLabel:     versionClassLabel & 0x000fffff,
+		PayloadLength: binary.BigEndian.Uint16(b[off+4:]),
+		NextHeader:    b[off+6],
+		HopLimit:      b[off+7],
+		SrcGID:        net.IP(b[off+8 : off+24]),
+		DstGID:        net.IP(b[off+24 : off+40]),
+	}
+	p.IBGRH = &p.ibGRH
+	off += 40
+
+	if p.ibGRH.NextHeader != IBNextHeaderBTH {
+		p.payloadOffset = off
+		return
+	}
+
+	p.decodeIBBaseTransport(b, off)
+}
+
+func (p *DecodedPacket) decodeIBBaseTransport(b []byte, off int) {
+	if len(b) < off+12 {
+		p.truncate(off)
+		return
+	}
+
+	p.ibBTH = IBBaseTransportHeader{
+		Opcode:           b[off],
+		SolicitedEvent:   b[off+1]&0x80 != 0,
+		MigrationRequest: b[off+1]&0x40 != 0,
+		PadCount:         (b[off+1] >> 4) & 0x03,
+		TransportVersion: b[off+1] & 0x0f,
+		PKey:             binary.BigEndian.Uint16(b[off+2:]),
+		DestQP:           binary.BigEndian.Uint32(b[off+4:]) & 0x00ffffff,
+		AckRequest:       b[off+8]&0x80 != 0,
+		PSN:              binary.BigEndian.Uint32(b[off+8:]) & 0x00ffffff,
+	}
+	p.IBBTH = &p.ibBTH
+
+	p.payloadOffset = off + 12
+}
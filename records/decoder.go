@@ -6,6 +6,7 @@ import (
 	"io"
 	"net"
 	"reflect"
+	"strconv"
 )
 
 type PostDecoder interface {
@@ -18,6 +19,8 @@ func DecodeFlow(r io.Reader, recordType uint32) (Record, error) {
 	switch recordType {
 	case TypeRawPacketFlowRecord:
 		return DecodeRawPacketFlow(r)
+	case TypeExtended80211AggregationFlowRecord:
+		return DecodeExtended80211AggregationFlow(r)
 	default:
 		if recordStruct, found := flowRecordTypes[recordType]; found {
 			data := reflect.New(reflect.TypeOf(recordStruct)).Elem()
@@ -90,6 +93,16 @@ func decodeInto(r io.Reader, s interface{}) (int, error) {
 			continue
 		}
 
+		// Blank fields are padding, skip them like binary.Read does
+		if structure.Field(i).Name == "_" {
+			n, err := io.CopyN(io.Discard, r, int64(binary.Size(reflect.Zero(field.Type()).Interface())))
+			bytesRead += int(n)
+			if err != nil {
+				return bytesRead, err
+			}
+			continue
+		}
+
 		//fmt.Printf("Kind: %s - %s\n", field.Kind(), field.CanSet())
 		//fmt.Printf("State: %s\n", s)
 
@@ -163,6 +176,24 @@ func decodeInto(r io.Reader, s interface{}) (int, error) {
 					}
 					bufferSize := reflect.Indirect(data).FieldByName(lengthField).Uint()
 
+					// Bounded arrays and strings must not exceed their maximum length
+					if maxLength := structure.Field(i).Tag.Get("maxLength"); maxLength != "" {
+						if max, err := strconv.ParseUint(maxLength, 10, 32); err == nil && bufferSize > max {
+							return bytesRead, fmt.Errorf("Length %d of %s exceeds its maximum length of %d", bufferSize, structure.Field(i).Name, max)
+						}
+					}
+
+					// The length comes from the wire, it must not exceed the data left in the record
+					if left, found := bytesLeft(r); found {
+						elemSize := binary.Size(reflect.Zero(field.Type().Elem()).Interface())
+						if elemSize < 1 {
+							elemSize = 1
+						}
+						if bufferSize*uint64(elemSize) > uint64(left) {
+							return bytesRead, fmt.Errorf("Length %d of %s exceeds the %d bytes left in the record", bufferSize, structure.Field(i).Name, left)
+						}
+					}
+
 					if bufferSize > 0 {
 						switch field.Type().Elem().Kind() {
 						case reflect.Struct, reflect.Slice, reflect.Array:
@@ -170,13 +201,16 @@ func decodeInto(r io.Reader, s interface{}) (int, error) {
 							field.Set(reflect.MakeSlice(field.Type(), int(bufferSize), int(bufferSize)))
 
 							for x := 0; x < int(bufferSize); x++ {
-								decodeInto(r, field.Index(x).Addr().Interface())
+								n, err := decodeInto(r, field.Index(x).Addr().Interface())
+								bytesRead += n
+								if err != nil {
+									return bytesRead, err
+								}
 							}
-						default:
-							//Apply padding
+						case reflect.Uint8:
+							// Opaque data is padded to a multiple of 4 bytes
 							size := bufferSize + (4-(bufferSize%4))%4
 
-							// For slices of defined length types we can look up the length and decode directly
 							field.Set(reflect.MakeSlice(field.Type(), int(size), int(size)))
 
 							// Read directly from io
@@ -184,13 +218,29 @@ func decodeInto(r io.Reader, s interface{}) (int, error) {
 								return bytesRead, err
 							}
 							bytesRead += binary.Size(field.Addr().Interface())
+
+							// Strip the padding, the length field holds the actual length
+							field.SetLen(int(bufferSize))
+						default:
+							// For slices of defined length types we can look up the length and decode directly
+							field.Set(reflect.MakeSlice(field.Type(), int(bufferSize), int(bufferSize)))
+
+							// Read directly from io
+							if err = binary.Read(r, binary.BigEndian, field.Addr().Interface()); err != nil {
+								return bytesRead, err
+							}
+							bytesRead += binary.Size(field.Addr().Interface())
 						}
 					}
 				}
 			case reflect.Struct:
 				// For structs we call Decode revursively
 				field.Set(reflect.Zero(field.Type()))
-				decodeInto(r, field.Addr().Interface())
+				n, err := decodeInto(r, field.Addr().Interface())
+				bytesRead += n
+				if err != nil {
+					return bytesRead, err
+				}
 
 			default:
 				return bytesRead, fmt.Errorf("Unhandled Field Kind: %s", field.Kind())
@@ -200,3 +250,16 @@ func decodeInto(r io.Reader, s interface{}) (int, error) {
 
 	return bytesRead, nil
 }
+
+// bytesLeft returns the number of bytes that can still be read from r,
+// found is false if r does not know its remaining length.
+func bytesLeft(r io.Reader) (left int64, found bool) {
+	switch r := r.(type) {
+	case *io.LimitedReader:
+		return r.N, true
+	case interface{ Len() int }:
+		return int64(r.Len()), true
+	}
+
+	return 0, false
+}
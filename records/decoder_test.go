@@ -15,6 +15,53 @@ func SkipHeaderBytes(r io.Reader) error {
 	return err
 }
 
+// roundTrip encodes rec, checks the length in the record header against the
+// encoded body and decodes the body again, which has to yield rec.
+func roundTrip(t *testing.T, rec Record) Record {
+	t.Helper()
+
+	b := &bytes.Buffer{}
+
+	err := rec.Encode(b)
+	if err != nil {
+		t.Fatalf("%s: %s", rec.RecordName(), err)
+	}
+
+	// Skip the header section. It's 8 bytes.
+	var headerBytes [8]byte
+
+	_, err = b.Read(headerBytes[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	size := b.Len()
+	if length := binary.BigEndian.Uint32(headerBytes[4:]); int(length) != size {
+		t.Errorf("%s: record length %d does not match the %d encoded bytes", rec.RecordName(), length, size)
+	}
+
+	// Flow and counter record types overlap, the registered struct tells them apart
+	decode := DecodeCounter
+	if registered, found := flowRecordTypes[uint32(rec.RecordType())]; found && reflect.TypeOf(registered) == reflect.TypeOf(rec) {
+		decode = DecodeFlow
+	}
+
+	decoded, err := decode(b, uint32(rec.RecordType()))
+	if err != nil {
+		t.Fatalf("%s: %s", rec.RecordName(), err)
+	}
+
+	if !reflect.DeepEqual(rec, decoded) {
+		t.Errorf("expected\n%+#v\n, got\n%+#v", rec, decoded)
+	}
+
+	if b.Len() != 0 {
+		t.Errorf("%s: %d of %d bytes left after decoding", rec.RecordName(), b.Len(), size)
+	}
+
+	return decoded
+}
+
 func TestDecodeGenericRecordStatic(t *testing.T) {
 	var binaryData []byte
 
@@ -27,7 +74,7 @@ func TestDecodeGenericRecordStatic(t *testing.T) {
 
 	buffer := bytes.NewBuffer(binaryData)
 	binary.Write(buffer, binary.BigEndian, &testFlow)
-	resultRecord, err := Decode(buffer, TypeExtendedSwitchFlowRecord)
+	resultRecord, err := DecodeFlow(buffer, TypeExtendedSwitchFlowRecord)
 	if err != nil {
 		t.Fatalf("Error: %s\n", err)
 	}
@@ -52,7 +99,7 @@ func TestDecodeGenericRecordDynamic(t *testing.T) {
 	testFlow.Encode(buffer)
 
 	SkipHeaderBytes(buffer)
-	resultRecord, err := Decode(buffer, TypeExtendedRouterFlowRecord)
+	resultRecord, err := DecodeFlow(buffer, TypeExtendedRouterFlowRecord)
 	if err != nil {
 		t.Fatalf("Error: %s\n", err)
 	}
@@ -61,3 +108,33 @@ func TestDecodeGenericRecordDynamic(t *testing.T) {
 		t.Errorf("expected\n%+#v\n, got\n%+#v", testFlow, resultRecord)
 	}
 }
+
+func TestEncodeDecodeOpaqueDataPadding(t *testing.T) {
+	type opaque struct {
+		DataLen uint32
+		Data    []byte `lengthLookUp:"DataLen"`
+		Trailer uint32
+	}
+
+	testData := opaque{DataLen: 5, Data: []byte("sflow"), Trailer: 42}
+
+	buffer := &bytes.Buffer{}
+	if err := Encode(buffer, testData); err != nil {
+		t.Fatal(err)
+	}
+
+	// 5 bytes of data are padded to 8 bytes
+	expected := []byte{0, 0, 0, 5, 's', 'f', 'l', 'o', 'w', 0, 0, 0, 0, 0, 0, 42}
+	if !bytes.Equal(buffer.Bytes(), expected) {
+		t.Errorf("expected\n%v\n, got\n%v", expected, buffer.Bytes())
+	}
+
+	var result opaque
+	if _, err := decodeInto(buffer, &result); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(testData, result) {
+		t.Errorf("expected\n%+#v\n, got\n%+#v", testData, result)
+	}
+}
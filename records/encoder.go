@@ -4,7 +4,9 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"net"
 	"reflect"
+	"strconv"
 )
 
 // Encode an sflow packet from 's' into 'w' - The structs datatypes define the binary representation
@@ -24,6 +26,21 @@ func Encode(w io.Writer, s interface{}) error {
 			continue
 		}
 
+		// Blank fields are padding, zero them like binary.Write does
+		if field.Name == "_" {
+			if _, err = w.Write(make([]byte, binary.Size(reflect.Zero(field.Type).Interface()))); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Bounded arrays and strings must not exceed their maximum length
+		if maxLength := field.Tag.Get("maxLength"); maxLength != "" && field.Type.Kind() == reflect.Slice {
+			if max, err := strconv.Atoi(maxLength); err == nil && data.FieldByIndex(field.Index).Len() > max {
+				return fmt.Errorf("Length %d of %s exceeds its maximum length of %d", data.FieldByIndex(field.Index).Len(), field.Name, max)
+			}
+		}
+
 		switch field.Type.Kind() {
 		case reflect.Uint8:
 			if err = binary.Write(w, binary.BigEndian, uint8(data.FieldByIndex(field.Index).Uint())); err != nil {
@@ -66,19 +83,34 @@ func Encode(w io.Writer, s interface{}) error {
 					}
 				}
 
-				if bufferSize == 4 && data.FieldByIndex(field.Index).Len() == 16 {
-					// We write only the last 4 Bytes of the buffer (net.IP uses 16 by default even for IPv4)
-					if err = binary.Write(w, binary.BigEndian, data.FieldByIndex(field.Index).Bytes()[12:]); err != nil {
+				// net.IP uses 16 bytes by default even for IPv4, convert it to the size on the wire
+				ip := net.IP(data.FieldByIndex(field.Index).Bytes())
+				if bufferSize == 4 {
+					ip = ip.To4()
+				} else {
+					ip = ip.To16()
+				}
+
+				// A missing or wrong family address would not match the record length
+				if uint32(len(ip)) != bufferSize {
+					return fmt.Errorf("Invalid IP address %v in field %s. Expected %d bytes", data.FieldByIndex(field.Index).Interface(), structure.Field(i).Name, bufferSize)
+				}
+
+				if err = binary.Write(w, binary.BigEndian, []byte(ip)); err != nil {
+					return err
+				}
+			default:
+				switch field.Type.Elem().Kind() {
+				case reflect.Uint8:
+					// Opaque data is padded to a multiple of 4 bytes
+					buffer := data.FieldByIndex(field.Index).Bytes()
+					if _, err = w.Write(buffer); err != nil {
 						return err
 					}
-				} else {
-					if err = binary.Write(w, binary.BigEndian, data.FieldByIndex(field.Index).Bytes()); err != nil {
+					if _, err = w.Write(make([]byte, xdrPadding(len(buffer)))); err != nil {
 						return err
 					}
-				}
-			default:
-				switch reflect.SliceOf(field.Type).Elem().String() {
-				case "[]uint32":
+				case reflect.Uint32:
 					// Write directly to io
 					if err = binary.Write(w, binary.BigEndian, data.FieldByIndex(field.Index).Interface()); err != nil {
 						return err
@@ -89,6 +121,16 @@ func Encode(w io.Writer, s interface{}) error {
 					}
 				}
 			}
+		case reflect.Array:
+			// Arrays have a fixed size and can be written directly
+			if err = binary.Write(w, binary.BigEndian, data.FieldByIndex(field.Index).Interface()); err != nil {
+				return err
+			}
+		case reflect.Struct:
+			// Nested structures are encoded recursively
+			if err = Encode(w, data.FieldByIndex(field.Index).Interface()); err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("Unhandled Field Kind: %s", field.Type.Kind())
 		}
@@ -96,3 +138,22 @@ func Encode(w io.Writer, s interface{}) error {
 
 	return err
 }
+
+// xdrPadding returns the number of bytes needed to pad
+// opaque data of length n to a multiple of 4 bytes.
+func xdrPadding(n int) int {
+	return (4 - n%4) % 4
+}
+
+// ipAddressSize returns the encoded size of an address
+// given its sflow address type (1: IPv4, 2: IPv6).
+func ipAddressSize(addressType uint32) int {
+	switch addressType {
+	case 1:
+		return 4
+	case 2:
+		return 16
+	}
+
+	return 0
+}
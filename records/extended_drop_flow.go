@@ -0,0 +1,148 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// ExtendedEgressQueueFlow - TypeExtendedEgressQueueFlowRecord
+type ExtendedEgressQueueFlow struct {
+	Queue uint32 /* egress queue number selected for sampled packet */
+}
+
+func (f ExtendedEgressQueueFlow) String() string {
+	type X ExtendedEgressQueueFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedEgressQueueFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedEgressQueueFlow) RecordName() string {
+	return "ExtendedEgressQueueFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedEgressQueueFlow) RecordType() int {
+	return TypeExtendedEgressQueueFlowRecord
+}
+
+func (f ExtendedEgressQueueFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedEgressQueueFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// ExtendedFunctionFlow - TypeExtendedFunctionFlowRecord
+type ExtendedFunctionFlow struct {
+	SymbolLen uint32
+	Symbol    []byte `lengthLookUp:"SymbolLen"` /* name of the software function that dropped the packet */
+}
+
+func (f ExtendedFunctionFlow) String() string {
+	type X ExtendedFunctionFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedFunctionFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedFunctionFlow) RecordName() string {
+	return "ExtendedFunctionFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedFunctionFlow) RecordType() int {
+	return TypeExtendedFunctionFlowRecord
+}
+
+func (f ExtendedFunctionFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.SymbolLen)
+	size += len(f.Symbol) + xdrPadding(len(f.Symbol))
+
+	return size
+}
+
+func (f ExtendedFunctionFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// ExtendedLinuxDropReasonFlow - TypeExtendedLinuxDropReasonFlowRecord
+type ExtendedLinuxDropReasonFlow struct {
+	ReasonLen uint32
+	Reason    []byte `lengthLookUp:"ReasonLen"` /* NET_DM_ATTR_REASON, e.g. NETFILTER_DROP */
+}
+
+func (f ExtendedLinuxDropReasonFlow) String() string {
+	type X ExtendedLinuxDropReasonFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedLinuxDropReasonFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedLinuxDropReasonFlow) RecordName() string {
+	return "ExtendedLinuxDropReasonFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedLinuxDropReasonFlow) RecordType() int {
+	return TypeExtendedLinuxDropReasonFlowRecord
+}
+
+func (f ExtendedLinuxDropReasonFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.ReasonLen)
+	size += len(f.Reason) + xdrPadding(len(f.Reason))
+
+	return size
+}
+
+func (f ExtendedLinuxDropReasonFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
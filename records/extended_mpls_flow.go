@@ -0,0 +1,265 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+)
+
+// ExtendedMplsFlow - TypeExtendedMplsFlowRecord
+type ExtendedMplsFlow struct {
+	NextHopType      uint32
+	NextHop          net.IP `ipVersionLookUp:"NextHopType"` /* Address of the next hop */
+	InLabelStackLen  uint32
+	InLabelStack     []uint32 `lengthLookUp:"InLabelStackLen"` /* Label stack of received packet */
+	OutLabelStackLen uint32
+	OutLabelStack    []uint32 `lengthLookUp:"OutLabelStackLen"` /* Label stack for transmitted packet */
+}
+
+func (f ExtendedMplsFlow) String() string {
+	type X ExtendedMplsFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedMplsFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedMplsFlow) RecordName() string {
+	return "ExtendedMplsFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedMplsFlow) RecordType() int {
+	return TypeExtendedMplsFlowRecord
+}
+
+func (f ExtendedMplsFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.NextHopType)
+	size += ipAddressSize(f.NextHopType)
+	size += binary.Size(f.InLabelStackLen)
+	size += 4 * len(f.InLabelStack)
+	size += binary.Size(f.OutLabelStackLen)
+	size += 4 * len(f.OutLabelStack)
+
+	return size
+}
+
+func (f ExtendedMplsFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// ExtendedMplsTunnelFlow - TypeExtendedMplsTunnelFlowRecord
+type ExtendedMplsTunnelFlow struct {
+	TunnelLspNameLen uint32
+	TunnelLspName    []byte `lengthLookUp:"TunnelLspNameLen"` /* Tunnel name */
+	TunnelID         uint32 /* Tunnel ID */
+	TunnelCos        uint32 /* Tunnel COS value */
+}
+
+func (f ExtendedMplsTunnelFlow) String() string {
+	type X ExtendedMplsTunnelFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedMplsTunnelFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedMplsTunnelFlow) RecordName() string {
+	return "ExtendedMplsTunnelFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedMplsTunnelFlow) RecordType() int {
+	return TypeExtendedMplsTunnelFlowRecord
+}
+
+func (f ExtendedMplsTunnelFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.TunnelLspNameLen)
+	size += len(f.TunnelLspName) + xdrPadding(len(f.TunnelLspName))
+	size += binary.Size(f.TunnelID)
+	size += binary.Size(f.TunnelCos)
+
+	return size
+}
+
+func (f ExtendedMplsTunnelFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// ExtendedMplsVcFlow - TypeExtendedMplsVcFlowRecord
+type ExtendedMplsVcFlow struct {
+	VcInstanceNameLen uint32
+	VcInstanceName    []byte `lengthLookUp:"VcInstanceNameLen"` /* VC instance name */
+	VllVcID           uint32 /* VLL/VC instance ID */
+	VcLabelCos        uint32 /* VC Label COS value */
+}
+
+func (f ExtendedMplsVcFlow) String() string {
+	type X ExtendedMplsVcFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedMplsVcFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedMplsVcFlow) RecordName() string {
+	return "ExtendedMplsVcFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedMplsVcFlow) RecordType() int {
+	return TypeExtendedMplsVcFlowRecord
+}
+
+func (f ExtendedMplsVcFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.VcInstanceNameLen)
+	size += len(f.VcInstanceName) + xdrPadding(len(f.VcInstanceName))
+	size += binary.Size(f.VllVcID)
+	size += binary.Size(f.VcLabelCos)
+
+	return size
+}
+
+func (f ExtendedMplsVcFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// ExtendedMplsFtnFlow - TypeExtendedMplsFtnFlowRecord
+type ExtendedMplsFtnFlow struct {
+	MplsFtnDescrLen uint32
+	MplsFtnDescr    []byte `lengthLookUp:"MplsFtnDescrLen"` /* FEC to next hop label entry description */
+	MplsFtnMask     uint32 /* FTN mask */
+}
+
+func (f ExtendedMplsFtnFlow) String() string {
+	type X ExtendedMplsFtnFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedMplsFtnFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedMplsFtnFlow) RecordName() string {
+	return "ExtendedMplsFtnFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedMplsFtnFlow) RecordType() int {
+	return TypeExtendedMplsFtnFlowRecord
+}
+
+func (f ExtendedMplsFtnFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.MplsFtnDescrLen)
+	size += len(f.MplsFtnDescr) + xdrPadding(len(f.MplsFtnDescr))
+	size += binary.Size(f.MplsFtnMask)
+
+	return size
+}
+
+func (f ExtendedMplsFtnFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// ExtendedMplsLdpFecFlow - TypeExtendedMplsLdpFecFlowRecord
+type ExtendedMplsLdpFecFlow struct {
+	MplsFecAddrPrefixLength uint32 /* FEC address prefix length */
+}
+
+func (f ExtendedMplsLdpFecFlow) String() string {
+	type X ExtendedMplsLdpFecFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedMplsLdpFecFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedMplsLdpFecFlow) RecordName() string {
+	return "ExtendedMplsLdpFecFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedMplsLdpFecFlow) RecordType() int {
+	return TypeExtendedMplsLdpFecFlowRecord
+}
+
+func (f ExtendedMplsLdpFecFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedMplsLdpFecFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
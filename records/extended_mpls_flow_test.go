@@ -0,0 +1,56 @@
+package records
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+)
+
+func TestEncodeDecodeExtendedMplsFlowRecords(t *testing.T) {
+	recs := []Record{
+		ExtendedMplsFlow{
+			NextHopType:      1,
+			NextHop:          net.IP{192, 0, 2, 1},
+			InLabelStackLen:  2,
+			InLabelStack:     []uint32{0x003e8140, 0x00003140},
+			OutLabelStackLen: 1,
+			OutLabelStack:    []uint32{0x00fa1140},
+		},
+		ExtendedMplsTunnelFlow{
+			TunnelLspNameLen: 5,
+			TunnelLspName:    []byte("lsp-a"),
+			TunnelID:         12,
+			TunnelCos:        3,
+		},
+		ExtendedMplsVcFlow{
+			VcInstanceNameLen: 4,
+			VcInstanceName:    []byte("vc-1"),
+			VllVcID:           100,
+			VcLabelCos:        5,
+		},
+		ExtendedMplsFtnFlow{
+			MplsFtnDescrLen: 6,
+			MplsFtnDescr:    []byte("ftn-42"),
+			MplsFtnMask:     24,
+		},
+		ExtendedMplsLdpFecFlow{
+			MplsFecAddrPrefixLength: 32,
+		},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+}
+
+func TestDecodeExtendedMplsFlowOversizedLabelStack(t *testing.T) {
+	b := &bytes.Buffer{}
+
+	// 8 labels of 4 bytes each announced, but only 16 bytes follow
+	binary.Write(b, binary.BigEndian, []uint32{1, 0xc0000201, 8, 1, 2, 3, 4})
+
+	if _, err := DecodeFlow(b, TypeExtendedMplsFlowRecord); err == nil {
+		t.Error("expected an error decoding a label stack larger than the record")
+	}
+}
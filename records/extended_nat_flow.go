@@ -0,0 +1,105 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+)
+
+// ExtendedNatFlow - TypeExtendedNatFlowRecord
+type ExtendedNatFlow struct {
+	SrcAddressType uint32
+	SrcAddress     net.IP `ipVersionLookUp:"SrcAddressType"` /* Source address */
+	DstAddressType uint32
+	DstAddress     net.IP `ipVersionLookUp:"DstAddressType"` /* Destination address */
+}
+
+func (f ExtendedNatFlow) String() string {
+	type X ExtendedNatFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedNatFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedNatFlow) RecordName() string {
+	return "ExtendedNatFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedNatFlow) RecordType() int {
+	return TypeExtendedNatFlowRecord
+}
+
+func (f ExtendedNatFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.SrcAddressType)
+	size += ipAddressSize(f.SrcAddressType)
+	size += binary.Size(f.DstAddressType)
+	size += ipAddressSize(f.DstAddressType)
+
+	return size
+}
+
+func (f ExtendedNatFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// ExtendedNatPortFlow - TypeExtendedNatPortFlowRecord
+type ExtendedNatPortFlow struct {
+	SrcPort uint32 /* Source port */
+	DstPort uint32 /* Destination port */
+}
+
+func (f ExtendedNatPortFlow) String() string {
+	type X ExtendedNatPortFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedNatPortFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedNatPortFlow) RecordName() string {
+	return "ExtendedNatPortFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedNatPortFlow) RecordType() int {
+	return TypeExtendedNatPortFlowRecord
+}
+
+func (f ExtendedNatPortFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedNatPortFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
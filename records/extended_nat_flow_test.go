@@ -0,0 +1,39 @@
+package records
+
+import (
+	"bytes"
+	"net"
+	"testing"
+)
+
+func TestEncodeDecodeExtendedNatFlowRecords(t *testing.T) {
+	recs := []Record{
+		ExtendedNatFlow{
+			SrcAddressType: 1,
+			SrcAddress:     net.IP{100, 64, 0, 10},
+			DstAddressType: 2,
+			DstAddress:     net.ParseIP("2001:db8::10"),
+		},
+		ExtendedNatPortFlow{
+			SrcPort: 40000,
+			DstPort: 443,
+		},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+}
+
+func TestEncodeExtendedNatFlowInvalidAddress(t *testing.T) {
+	recs := []Record{
+		ExtendedNatFlow{SrcAddressType: 1, DstAddressType: 1},
+		ExtendedNatFlow{SrcAddressType: 1, SrcAddress: net.ParseIP("2001:db8::10"), DstAddressType: 1, DstAddress: net.IP{192, 0, 2, 1}},
+	}
+
+	for _, rec := range recs {
+		if err := rec.Encode(&bytes.Buffer{}); err == nil {
+			t.Errorf("expected an error encoding %+v", rec)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package records
 
 import (
 	"encoding/binary"
+	"fmt"
 	"io"
 	"net"
 )
@@ -15,22 +16,43 @@ type ExtendedSocketIPv4Flow struct {
 	RemotePort uint32
 }
 
+func (f ExtendedSocketIPv4Flow) String() string {
+	type X ExtendedSocketIPv4Flow
+	x := X(f)
+	return fmt.Sprintf("ExtendedSocketIPv4Flow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
 func (f ExtendedSocketIPv4Flow) RecordName() string {
 	return "ExtendedSocketIPv4Flow"
 }
 
+// RecordType returns the ID of the sflow flow record
 func (f ExtendedSocketIPv4Flow) RecordType() int {
 	return TypeExtendedSocketIPv4FlowRecord
 }
 
 func (f ExtendedSocketIPv4Flow) calculateBinarySize() int {
-	return binary.Size(f)
+	// Three 32 bit fields and two IPv4 addresses
+	return 3*4 + 2*net.IPv4len
 }
 
 func (f ExtendedSocketIPv4Flow) Encode(w io.Writer) error {
 	var err error
 
-	return err
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
 }
 
 // ExtendedSocketIPv6Flow - TypeExtendedSocketIPv6FlowRecord
@@ -42,66 +64,127 @@ type ExtendedSocketIPv6Flow struct {
 	RemotePort uint32
 }
 
+func (f ExtendedSocketIPv6Flow) String() string {
+	type X ExtendedSocketIPv6Flow
+	x := X(f)
+	return fmt.Sprintf("ExtendedSocketIPv6Flow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
 func (f ExtendedSocketIPv6Flow) RecordName() string {
 	return "ExtendedSocketIPv6Flow"
 }
 
+// RecordType returns the ID of the sflow flow record
 func (f ExtendedSocketIPv6Flow) RecordType() int {
 	return TypeExtendedSocketIPv6FlowRecord
 }
 
 func (f ExtendedSocketIPv6Flow) calculateBinarySize() int {
-	return binary.Size(f)
+	// Three 32 bit fields and two IPv6 addresses
+	return 3*4 + 2*net.IPv6len
 }
 
 func (f ExtendedSocketIPv6Flow) Encode(w io.Writer) error {
 	var err error
 
-	return err
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
 }
 
-// ExtendedProxySocketIPv4 - TypeExtendedProxySocketIPv4FlowRecord
+// ExtendedProxySocketIPv4Flow - TypeExtendedProxySocketIPv4FlowRecord
 type ExtendedProxySocketIPv4Flow struct {
 	Socket ExtendedSocketIPv4Flow
 }
 
+func (f ExtendedProxySocketIPv4Flow) String() string {
+	type X ExtendedProxySocketIPv4Flow
+	x := X(f)
+	return fmt.Sprintf("ExtendedProxySocketIPv4Flow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
 func (f ExtendedProxySocketIPv4Flow) RecordName() string {
 	return "ExtendedProxySocketIPv4Flow"
 }
 
+// RecordType returns the ID of the sflow flow record
 func (f ExtendedProxySocketIPv4Flow) RecordType() int {
 	return TypeExtendedProxySocketIPv4FlowRecord
 }
 
 func (f ExtendedProxySocketIPv4Flow) calculateBinarySize() int {
-	return binary.Size(f)
+	return f.Socket.calculateBinarySize()
 }
 
 func (f ExtendedProxySocketIPv4Flow) Encode(w io.Writer) error {
 	var err error
 
-	return err
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
 }
 
-// ExtendedProxySocketIPv6 - TypeExtendedProxySocketIPv6FlowRecord
+// ExtendedProxySocketIPv6Flow - TypeExtendedProxySocketIPv6FlowRecord
 type ExtendedProxySocketIPv6Flow struct {
 	Socket ExtendedSocketIPv6Flow
 }
 
+func (f ExtendedProxySocketIPv6Flow) String() string {
+	type X ExtendedProxySocketIPv6Flow
+	x := X(f)
+	return fmt.Sprintf("ExtendedProxySocketIPv6Flow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
 func (f ExtendedProxySocketIPv6Flow) RecordName() string {
 	return "ExtendedProxySocketIPv6Flow"
 }
 
+// RecordType returns the ID of the sflow flow record
 func (f ExtendedProxySocketIPv6Flow) RecordType() int {
 	return TypeExtendedProxySocketIPv6FlowRecord
 }
 
 func (f ExtendedProxySocketIPv6Flow) calculateBinarySize() int {
-	return binary.Size(f)
+	return f.Socket.calculateBinarySize()
 }
 
 func (f ExtendedProxySocketIPv6Flow) Encode(w io.Writer) error {
 	var err error
 
-	return err
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
 }
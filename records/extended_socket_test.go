@@ -0,0 +1,76 @@
+package records
+
+import (
+	"bytes"
+	"net"
+	"testing"
+)
+
+func TestEncodeDecodeExtendedSocketFlowRecords(t *testing.T) {
+	ipv4 := ExtendedSocketIPv4Flow{
+		Protocol:   IPProtocolTCP,
+		LocalIP:    net.IP{192, 0, 2, 10},
+		RemoteIP:   net.IP{198, 51, 100, 7},
+		LocalPort:  443,
+		RemotePort: 51000,
+	}
+	ipv6 := ExtendedSocketIPv6Flow{
+		Protocol:   IPProtocolTCP,
+		LocalIP:    net.ParseIP("2001:db8::10"),
+		RemoteIP:   net.ParseIP("2001:db8:1::7"),
+		LocalPort:  443,
+		RemotePort: 51000,
+	}
+
+	tests := []struct {
+		rec interface {
+			Record
+			calculateBinarySize() int
+		}
+		size int
+	}{
+		{ipv4, 20},
+		{ipv6, 44},
+		{ExtendedProxySocketIPv4Flow{Socket: ipv4}, 20},
+		{ExtendedProxySocketIPv6Flow{Socket: ipv6}, 44},
+	}
+
+	for _, test := range tests {
+		if size := test.rec.calculateBinarySize(); size != test.size {
+			t.Errorf("%s: expected record length %d, got %d", test.rec.RecordName(), test.size, size)
+		}
+
+		roundTrip(t, test.rec)
+	}
+}
+
+func TestEncodeExtendedSocketFlowInvalidAddress(t *testing.T) {
+	ipv4 := net.IP{192, 0, 2, 10}
+	ipv6 := net.ParseIP("2001:db8::10")
+
+	recs := []Record{
+		ExtendedSocketIPv4Flow{},
+		ExtendedSocketIPv6Flow{},
+		ExtendedSocketIPv4Flow{LocalIP: ipv6, RemoteIP: ipv4},
+		ExtendedProxySocketIPv4Flow{Socket: ExtendedSocketIPv4Flow{LocalIP: ipv4}},
+		ExtendedProxySocketIPv6Flow{Socket: ExtendedSocketIPv6Flow{RemoteIP: ipv6}},
+	}
+
+	for _, rec := range recs {
+		if err := rec.Encode(&bytes.Buffer{}); err == nil {
+			t.Errorf("expected an error encoding %+v", rec)
+		}
+	}
+
+	// IPv4 addresses are written as IPv4-mapped IPv6 addresses
+	rec := ExtendedSocketIPv6Flow{LocalIP: ipv4, RemoteIP: ipv6}
+
+	b := &bytes.Buffer{}
+	if err := rec.Encode(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.Len() != 8+rec.calculateBinarySize() {
+		t.Errorf("expected %d bytes, got %d", 8+rec.calculateBinarySize(), b.Len())
+	}
+}
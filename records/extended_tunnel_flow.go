@@ -0,0 +1,447 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// SampledEthernet is the sampled_ethernet structure carried by the layer 2 tunnel records
+type SampledEthernet struct {
+	Length       uint32  /* The length of the MAC packet including FCS octets */
+	SrcMac       [6]byte /* Source MAC address */
+	_            [2]byte // mac addresses are padded to 8 bytes
+	DstMac       [6]byte /* Destination MAC address */
+	_            [2]byte
+	EthernetType uint32 /* Ethernet packet type */
+}
+
+// ExtendedL2TunnelEgressFlow - TypeExtendedL2TunnelEgressFlowRecord
+type ExtendedL2TunnelEgressFlow struct {
+	Header SampledEthernet /* Outer layer 2 header of the packet on egress */
+}
+
+func (f ExtendedL2TunnelEgressFlow) String() string {
+	type X ExtendedL2TunnelEgressFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedL2TunnelEgressFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedL2TunnelEgressFlow) RecordName() string {
+	return "ExtendedL2TunnelEgressFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedL2TunnelEgressFlow) RecordType() int {
+	return TypeExtendedL2TunnelEgressFlowRecord
+}
+
+func (f ExtendedL2TunnelEgressFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedL2TunnelEgressFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// ExtendedL2TunnelIngressFlow - TypeExtendedL2TunnelIngressFlowRecord
+type ExtendedL2TunnelIngressFlow struct {
+	Header SampledEthernet /* Outer layer 2 header of the packet on ingress */
+}
+
+func (f ExtendedL2TunnelIngressFlow) String() string {
+	type X ExtendedL2TunnelIngressFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedL2TunnelIngressFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedL2TunnelIngressFlow) RecordName() string {
+	return "ExtendedL2TunnelIngressFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedL2TunnelIngressFlow) RecordType() int {
+	return TypeExtendedL2TunnelIngressFlowRecord
+}
+
+func (f ExtendedL2TunnelIngressFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedL2TunnelIngressFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// ExtendedIPv4TunnelEgressFlow - TypeExtendedIPv4TunnelEgressFlowRecord
+type ExtendedIPv4TunnelEgressFlow struct {
+	Header SampledIPv4Flow /* Outer IPv4 header of the packet on egress */
+}
+
+func (f ExtendedIPv4TunnelEgressFlow) String() string {
+	type X ExtendedIPv4TunnelEgressFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedIPv4TunnelEgressFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedIPv4TunnelEgressFlow) RecordName() string {
+	return "ExtendedIPv4TunnelEgressFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedIPv4TunnelEgressFlow) RecordType() int {
+	return TypeExtendedIPv4TunnelEgressFlowRecord
+}
+
+func (f ExtendedIPv4TunnelEgressFlow) calculateBinarySize() int {
+	return f.Header.calculateBinarySize()
+}
+
+func (f ExtendedIPv4TunnelEgressFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// ExtendedIPv4TunnelIngressFlow - TypeExtendedIPv4TunnelIngressFlowRecord
+type ExtendedIPv4TunnelIngressFlow struct {
+	Header SampledIPv4Flow /* Outer IPv4 header of the packet on ingress */
+}
+
+func (f ExtendedIPv4TunnelIngressFlow) String() string {
+	type X ExtendedIPv4TunnelIngressFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedIPv4TunnelIngressFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedIPv4TunnelIngressFlow) RecordName() string {
+	return "ExtendedIPv4TunnelIngressFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedIPv4TunnelIngressFlow) RecordType() int {
+	return TypeExtendedIPv4TunnelIngressFlowRecord
+}
+
+func (f ExtendedIPv4TunnelIngressFlow) calculateBinarySize() int {
+	return f.Header.calculateBinarySize()
+}
+
+func (f ExtendedIPv4TunnelIngressFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// ExtendedIPv6TunnelEgressFlow - TypeExtendedIPv6TunnelEgressFlowRecord
+type ExtendedIPv6TunnelEgressFlow struct {
+	Header SampledIPv6Flow /* Outer IPv6 header of the packet on egress */
+}
+
+func (f ExtendedIPv6TunnelEgressFlow) String() string {
+	type X ExtendedIPv6TunnelEgressFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedIPv6TunnelEgressFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedIPv6TunnelEgressFlow) RecordName() string {
+	return "ExtendedIPv6TunnelEgressFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedIPv6TunnelEgressFlow) RecordType() int {
+	return TypeExtendedIPv6TunnelEgressFlowRecord
+}
+
+func (f ExtendedIPv6TunnelEgressFlow) calculateBinarySize() int {
+	return f.Header.calculateBinarySize()
+}
+
+func (f ExtendedIPv6TunnelEgressFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// ExtendedIPv6TunnelIngressFlow - TypeExtendedIPv6TunnelIngressFlowRecord
+type ExtendedIPv6TunnelIngressFlow struct {
+	Header SampledIPv6Flow /* Outer IPv6 header of the packet on ingress */
+}
+
+func (f ExtendedIPv6TunnelIngressFlow) String() string {
+	type X ExtendedIPv6TunnelIngressFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedIPv6TunnelIngressFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedIPv6TunnelIngressFlow) RecordName() string {
+	return "ExtendedIPv6TunnelIngressFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedIPv6TunnelIngressFlow) RecordType() int {
+	return TypeExtendedIPv6TunnelIngressFlowRecord
+}
+
+func (f ExtendedIPv6TunnelIngressFlow) calculateBinarySize() int {
+	return f.Header.calculateBinarySize()
+}
+
+func (f ExtendedIPv6TunnelIngressFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// ExtendedDecapsulateEgressFlow - TypeExtendedDecapsulateEgressFlowRecord
+type ExtendedDecapsulateEgressFlow struct {
+	InnerHeaderOffset uint32 /* Offset of the inner header within the sampled header, in bytes */
+}
+
+func (f ExtendedDecapsulateEgressFlow) String() string {
+	type X ExtendedDecapsulateEgressFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedDecapsulateEgressFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedDecapsulateEgressFlow) RecordName() string {
+	return "ExtendedDecapsulateEgressFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedDecapsulateEgressFlow) RecordType() int {
+	return TypeExtendedDecapsulateEgressFlowRecord
+}
+
+func (f ExtendedDecapsulateEgressFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedDecapsulateEgressFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// ExtendedDecapsulateIngressFlow - TypeExtendedDecapsulateIngressFlowRecord
+type ExtendedDecapsulateIngressFlow struct {
+	InnerHeaderOffset uint32 /* Offset of the inner header within the sampled header, in bytes */
+}
+
+func (f ExtendedDecapsulateIngressFlow) String() string {
+	type X ExtendedDecapsulateIngressFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedDecapsulateIngressFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedDecapsulateIngressFlow) RecordName() string {
+	return "ExtendedDecapsulateIngressFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedDecapsulateIngressFlow) RecordType() int {
+	return TypeExtendedDecapsulateIngressFlowRecord
+}
+
+func (f ExtendedDecapsulateIngressFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedDecapsulateIngressFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// ExtendedVNIEgressFlow - TypeExtendedVNIEgressFlowRecord
+type ExtendedVNIEgressFlow struct {
+	VNI uint32 /* Virtual network identifier of the packet on egress */
+}
+
+func (f ExtendedVNIEgressFlow) String() string {
+	type X ExtendedVNIEgressFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedVNIEgressFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedVNIEgressFlow) RecordName() string {
+	return "ExtendedVNIEgressFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedVNIEgressFlow) RecordType() int {
+	return TypeExtendedVNIEgressFlowRecord
+}
+
+func (f ExtendedVNIEgressFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedVNIEgressFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// ExtendedVNIIngressFlow - TypeExtendedVNIIngressFlowRecord
+type ExtendedVNIIngressFlow struct {
+	VNI uint32 /* Virtual network identifier of the packet on ingress */
+}
+
+func (f ExtendedVNIIngressFlow) String() string {
+	type X ExtendedVNIIngressFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedVNIIngressFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedVNIIngressFlow) RecordName() string {
+	return "ExtendedVNIIngressFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedVNIIngressFlow) RecordType() int {
+	return TypeExtendedVNIIngressFlowRecord
+}
+
+func (f ExtendedVNIIngressFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedVNIIngressFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
@@ -0,0 +1,67 @@
+package records
+
+import (
+	"bytes"
+	"net"
+	"testing"
+)
+
+func TestEncodeDecodeExtendedTunnelFlowRecords(t *testing.T) {
+	outerIPv4 := SampledIPv4Flow{
+		Length:   1550,
+		Protocol: IPProtocolUDP,
+		SrcIP:    net.IP{192, 0, 2, 1},
+		DstIP:    net.IP{192, 0, 2, 2},
+		SrcPort:  49152,
+		DstPort:  UDPPortVXLAN,
+	}
+	outerIPv6 := SampledIPv6Flow{
+		Length:   1570,
+		Protocol: IPProtocolUDP,
+		SrcIP:    net.ParseIP("2001:db8::1"),
+		DstIP:    net.ParseIP("2001:db8::2"),
+		SrcPort:  49152,
+		DstPort:  UDPPortGeneve,
+	}
+	outerEthernet := SampledEthernet{
+		Length:       1568,
+		SrcMac:       [6]byte{0x00, 0x00, 0x5e, 0x00, 0x53, 0x01},
+		DstMac:       [6]byte{0x00, 0x00, 0x5e, 0x00, 0x53, 0x02},
+		EthernetType: EtherTypeIPv4,
+	}
+
+	recs := []Record{
+		ExtendedL2TunnelEgressFlow{Header: outerEthernet},
+		ExtendedL2TunnelIngressFlow{Header: outerEthernet},
+		ExtendedIPv4TunnelEgressFlow{Header: outerIPv4},
+		ExtendedIPv4TunnelIngressFlow{Header: outerIPv4},
+		ExtendedIPv6TunnelEgressFlow{Header: outerIPv6},
+		ExtendedIPv6TunnelIngressFlow{Header: outerIPv6},
+		ExtendedDecapsulateEgressFlow{InnerHeaderOffset: 50},
+		ExtendedDecapsulateIngressFlow{InnerHeaderOffset: 50},
+		ExtendedVNIEgressFlow{VNI: 10100},
+		ExtendedVNIIngressFlow{VNI: 10200},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+
+	if size := (ExtendedL2TunnelEgressFlow{}).calculateBinarySize(); size != 24 {
+		t.Errorf("expected a sampled_ethernet size of 24, got %d", size)
+	}
+}
+
+func TestEncodeExtendedTunnelFlowInvalidAddress(t *testing.T) {
+	recs := []Record{
+		ExtendedIPv4TunnelEgressFlow{},
+		ExtendedIPv4TunnelIngressFlow{Header: SampledIPv4Flow{SrcIP: net.ParseIP("2001:db8::1"), DstIP: net.IP{192, 0, 2, 2}}},
+		ExtendedIPv6TunnelEgressFlow{},
+	}
+
+	for _, rec := range recs {
+		if err := rec.Encode(&bytes.Buffer{}); err == nil {
+			t.Errorf("expected an error encoding %+v", rec)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// URL directions of ExtendedURLFlow
+const (
+	URLDirectionSrc = 1 // URL is associated with the source address
+	URLDirectionDst = 2 // URL is associated with the destination address
+)
+
+// ExtendedURLFlow - TypeExtendedURLFlowRecord
+type ExtendedURLFlow struct {
+	Direction uint32 // 1: Source address || 2: Destination address
+	URLLen    uint32
+	URL       []byte `lengthLookUp:"URLLen"` /* URL associated with the packet flow */
+	HostLen   uint32
+	Host      []byte `lengthLookUp:"HostLen"` /* The host field from the HTTP header */
+}
+
+func (f ExtendedURLFlow) String() string {
+	type X ExtendedURLFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedURLFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedURLFlow) RecordName() string {
+	return "ExtendedURLFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedURLFlow) RecordType() int {
+	return TypeExtendedURLFlowRecord
+}
+
+func (f ExtendedURLFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.Direction)
+	size += binary.Size(f.URLLen)
+	size += len(f.URL) + xdrPadding(len(f.URL))
+	size += binary.Size(f.HostLen)
+	size += len(f.Host) + xdrPadding(len(f.Host))
+
+	return size
+}
+
+func (f ExtendedURLFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
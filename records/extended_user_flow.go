@@ -0,0 +1,64 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// ExtendedUserFlow - TypeExtendedUserFlowRecord
+type ExtendedUserFlow struct {
+	SrcCharset uint32 /* Character set for SrcUser, MIBEnum value from RFC 2978 */
+	SrcUserLen uint32
+	SrcUser    []byte `lengthLookUp:"SrcUserLen"` /* User ID associated with packet source */
+	DstCharset uint32 /* Character set for DstUser, MIBEnum value from RFC 2978 */
+	DstUserLen uint32
+	DstUser    []byte `lengthLookUp:"DstUserLen"` /* User ID associated with packet destination */
+}
+
+func (f ExtendedUserFlow) String() string {
+	type X ExtendedUserFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedUserFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedUserFlow) RecordName() string {
+	return "ExtendedUserFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedUserFlow) RecordType() int {
+	return TypeExtendedUserFlowRecord
+}
+
+func (f ExtendedUserFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.SrcCharset)
+	size += binary.Size(f.SrcUserLen)
+	size += len(f.SrcUser) + xdrPadding(len(f.SrcUser))
+	size += binary.Size(f.DstCharset)
+	size += binary.Size(f.DstUserLen)
+	size += len(f.DstUser) + xdrPadding(len(f.DstUser))
+
+	return size
+}
+
+func (f ExtendedUserFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
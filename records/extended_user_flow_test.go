@@ -0,0 +1,47 @@
+package records
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestEncodeDecodeExtendedUserAndURLFlowRecords(t *testing.T) {
+	recs := []Record{
+		ExtendedUserFlow{
+			SrcCharset: 106, // UTF-8
+			SrcUserLen: 5,
+			SrcUser:    []byte("alice"),
+			DstCharset: 106,
+			DstUserLen: 3,
+			DstUser:    []byte("bob"),
+		},
+		ExtendedURLFlow{
+			Direction: URLDirectionDst,
+			URLLen:    14,
+			URL:       []byte("/index.html?a1"),
+			HostLen:   11,
+			Host:      []byte("example.com"),
+		},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+}
+
+func TestDecodeExtendedURLFlowOversizedLength(t *testing.T) {
+	// A URL length of almost 4 GiB in an 8 byte record
+	data := []byte{0, 0, 0, 1, 0xff, 0xff, 0xff, 0xf0}
+
+	readers := map[string]io.Reader{
+		"bytes.Reader":     bytes.NewReader(data),
+		"io.LimitedReader": &io.LimitedReader{R: bytes.NewReader(append(data, make([]byte, 64)...)), N: int64(len(data))},
+	}
+
+	for name, r := range readers {
+		if _, err := DecodeFlow(r, TypeExtendedURLFlowRecord); err == nil {
+			t.Errorf("%s: expected an error decoding a length larger than the record", name)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// ExtendedVlanTunnelFlow - TypeExtendedVlanFlowRecord
+type ExtendedVlanTunnelFlow struct {
+	StackLen uint32
+	Stack    []uint32 `lengthLookUp:"StackLen"` /* List of stripped 802.1Q TPID/TCI layers, outermost first */
+}
+
+func (f ExtendedVlanTunnelFlow) String() string {
+	type X ExtendedVlanTunnelFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedVlanTunnelFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedVlanTunnelFlow) RecordName() string {
+	return "ExtendedVlanTunnelFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedVlanTunnelFlow) RecordType() int {
+	return TypeExtendedVlanFlowRecord
+}
+
+// VLANIDs returns the VLAN identifiers of the stack, outermost first
+func (f ExtendedVlanTunnelFlow) VLANIDs() []uint16 {
+	ids := make([]uint16, 0, len(f.Stack))
+	for _, layer := range f.Stack {
+		// Each layer holds the TPID in the upper and the TCI in the lower 16 bits
+		ids = append(ids, uint16(layer&0x0fff))
+	}
+
+	return ids
+}
+
+func (f ExtendedVlanTunnelFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.StackLen)
+	size += 4 * len(f.Stack)
+
+	return size
+}
+
+func (f ExtendedVlanTunnelFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
@@ -0,0 +1,36 @@
+package records
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeDecodeExtendedVlanTunnelFlowRecord(t *testing.T) {
+	rec := ExtendedVlanTunnelFlow{
+		StackLen: 2,
+		Stack: []uint32{
+			EtherTypeQinQ<<16 | 0x2064, // PCP 1, VLAN 100
+			EtherTypeVLAN<<16 | 0x00c8, // VLAN 200
+		},
+	}
+
+	decoded := roundTrip(t, rec)
+
+	ids := decoded.(ExtendedVlanTunnelFlow).VLANIDs()
+	if !reflect.DeepEqual(ids, []uint16{100, 200}) {
+		t.Errorf("expected VLAN IDs [100 200], got %v", ids)
+	}
+}
+
+func TestDecodeExtendedVlanTunnelFlowOversizedStack(t *testing.T) {
+	b := &bytes.Buffer{}
+
+	// Two tags announced, but only one follows
+	binary.Write(b, binary.BigEndian, []uint32{2, EtherTypeVLAN<<16 | 0x0064})
+
+	if _, err := DecodeFlow(b, TypeExtendedVlanFlowRecord); err == nil {
+		t.Error("expected an error decoding a tag stack larger than the record")
+	}
+}
@@ -1,88 +1,274 @@
 package records
 
-/*import (
+import (
+	"encoding/binary"
+	"fmt"
 	"io"
+	"net"
 )
 
+// MachineType is the processor family of a host, see HostDescriptionCounter.
+// The list may be expanded over time, so unknown values have to be expected.
+type MachineType uint32
+
+// Machine types as maintained at www.sflow.org
+const (
+	MachineTypeUnknown MachineType = 0
+	MachineTypeOther   MachineType = 1
+	MachineTypeX86     MachineType = 2
+	MachineTypeX86_64  MachineType = 3
+	MachineTypeIA64    MachineType = 4
+	MachineTypeSPARC   MachineType = 5
+	MachineTypeAlpha   MachineType = 6
+	MachineTypePowerPC MachineType = 7
+	MachineTypeM68K    MachineType = 8
+	MachineTypeMIPS    MachineType = 9
+	MachineTypeARM     MachineType = 10
+	MachineTypeHPPA    MachineType = 11
+	MachineTypeS390    MachineType = 12
+)
+
+var machineTypeNames = map[MachineType]string{
+	MachineTypeUnknown: "unknown",
+	MachineTypeOther:   "other",
+	MachineTypeX86:     "x86",
+	MachineTypeX86_64:  "x86_64",
+	MachineTypeIA64:    "ia64",
+	MachineTypeSPARC:   "sparc",
+	MachineTypeAlpha:   "alpha",
+	MachineTypePowerPC: "powerpc",
+	MachineTypeM68K:    "m68k",
+	MachineTypeMIPS:    "mips",
+	MachineTypeARM:     "arm",
+	MachineTypeHPPA:    "hppa",
+	MachineTypeS390:    "s390",
+}
+
+// String returns the name of the machine type as used in the sFlow specification.
+func (t MachineType) String() string {
+	if name, found := machineTypeNames[t]; found {
+		return name
+	}
+
+	return fmt.Sprintf("MachineType(%d)", uint32(t))
+}
+
+// OSName is the operating system of a host, see HostDescriptionCounter.
+// The list may be expanded over time, so unknown values have to be expected.
+type OSName uint32
+
+// Operating systems as maintained at www.sflow.org
+const (
+	OSNameUnknown   OSName = 0
+	OSNameOther     OSName = 1
+	OSNameLinux     OSName = 2
+	OSNameWindows   OSName = 3
+	OSNameDarwin    OSName = 4
+	OSNameHPUX      OSName = 5
+	OSNameAIX       OSName = 6
+	OSNameDragonfly OSName = 7
+	OSNameFreeBSD   OSName = 8
+	OSNameNetBSD    OSName = 9
+	OSNameOpenBSD   OSName = 10
+	OSNameOSF       OSName = 11
+	OSNameSolaris   OSName = 12
+)
+
+var osNameNames = map[OSName]string{
+	OSNameUnknown:   "unknown",
+	OSNameOther:     "other",
+	OSNameLinux:     "linux",
+	OSNameWindows:   "windows",
+	OSNameDarwin:    "darwin",
+	OSNameHPUX:      "hpux",
+	OSNameAIX:       "aix",
+	OSNameDragonfly: "dragonfly",
+	OSNameFreeBSD:   "freebsd",
+	OSNameNetBSD:    "netbsd",
+	OSNameOpenBSD:   "openbsd",
+	OSNameOSF:       "osf",
+	OSNameSolaris:   "solaris",
+}
+
+// String returns the name of the operating system as used in the sFlow specification.
+func (n OSName) String() string {
+	if name, found := osNameNames[n]; found {
+		return name
+	}
+
+	return fmt.Sprintf("OSName(%d)", uint32(n))
+}
+
+// HostDescriptionCounter - TypeHostDescriptionCounterRecord
 type HostDescriptionCounter struct {
-	HostnameLen uint32
-	Hostname []byte `lengthLookUp:"HostnameLen"`
-	UUID [16]byte
-	MachineType uint32
-	OSName uint32
+	HostnameLen  uint32
+	Hostname     []byte      `lengthLookUp:"HostnameLen" maxLength:"64"` /* hostname, empty if unknown */
+	UUID         [16]byte    /* 16 bytes binary UUID, zero if unknown */
+	MachineType  MachineType /* the processor family */
+	OSName       OSName      /* Operating system */
 	OSReleaseLen uint32
-	OSRelease []byte `lengthLookUp:"OSReleaseLen"`
+	OSRelease    []byte `lengthLookUp:"OSReleaseLen" maxLength:"32"` /* e.g. 2.6.9-42.ELsmp,xp-sp3, empty if unknown */
+}
+
+func (f HostDescriptionCounter) String() string {
+	type X HostDescriptionCounter
+	x := X(f)
+	return fmt.Sprintf("HostDescriptionCounter: %+v", x)
 }
 
-// RecordName returns the Name of this flow record
+// RecordName returns the Name of this counter record
 func (f HostDescriptionCounter) RecordName() string {
 	return "HostDescriptionCounter"
 }
 
-// RecordType returns the ID of the sflow flow record
+// RecordType returns the ID of the sflow counter record
 func (f HostDescriptionCounter) RecordType() int {
 	return TypeHostDescriptionCounterRecord
 }
 
+func (f HostDescriptionCounter) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.HostnameLen)
+	size += len(f.Hostname) + xdrPadding(len(f.Hostname))
+	size += binary.Size(f.UUID)
+	size += binary.Size(f.MachineType)
+	size += binary.Size(f.OSName)
+	size += binary.Size(f.OSReleaseLen)
+	size += len(f.OSRelease) + xdrPadding(len(f.OSRelease))
+
+	return size
+}
+
 func (f HostDescriptionCounter) Encode(w io.Writer) error {
 	var err error
 
-	return err
-}*/
-
-//enum machine_type {
-//unknown = 0,
-//other   = 1,
-//x86     = 2,
-//x86_64  = 3,
-//ia64    = 4,
-//sparc   = 5,
-//alpha   = 6,
-//powerpc = 7,
-//m68k    = 8,
-//mips    = 9,
-//arm     = 10,
-//hppa    = 11,
-//s390    = 12
-//}
-//
-///* The os_name enumeration may be expanded over time.
-//   Applications receiving sFlow must be prepared to receive
-//   host_descr structures with unknown machine_type values.
-//
-//   The authoritative list of machine types will be maintained
-//   at www.sflow.org */
-//
-//enum os_name {
-//unknown   = 0,
-//other     = 1,
-//
-//
-//
-//FINAL                           sFlow.org                       [Page 7]
-//
-//FINAL                     sFlow Host Structures                July 2010
-//
-//
-//linux     = 2,
-//windows   = 3,
-//darwin    = 4,
-//hpux      = 5,
-//aix       = 6,
-//dragonfly = 7,
-//freebsd   = 8,
-//netbsd    = 9,
-//openbsd   = 10,
-//osf       = 11,
-//solaris   = 12
-//}
-//
-///* Physical or virtual host description */
-///* opaque = counter_data; enterprise = 0; format = 2000 */
-//struct host_descr {
-//string hostname<64>;       /* hostname, empty if unknown */
-//opaque uuid<16>;           /* 16 bytes binary UUID, empty if unknown */
-//machine_type machine_type; /* the processor family */
-//os_name os_name;           /* Operating system */
-//string os_release<32>;     /* e.g. 2.6.9-42.ELsmp,xp-sp3, empty if unknown */
-//}
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// HostAdapterMAC is a MAC address of a HostAdapter, padded to 8 bytes
+type HostAdapterMAC struct {
+	Addr [6]byte
+	_    [2]byte
+}
+
+// HardwareAddr returns the MAC address as a net.HardwareAddr
+func (m HostAdapterMAC) HardwareAddr() net.HardwareAddr {
+	return net.HardwareAddr(m.Addr[:])
+}
+
+// HostAdapter is a network adapter of a host and its MAC addresses
+type HostAdapter struct {
+	IfIndex         uint32 /* ifIndex associated with adapter, 0 if unknown */
+	MACAddressesLen uint32
+	MACAddresses    []HostAdapterMAC `lengthLookUp:"MACAddressesLen"` /* Adapter MAC address(es) */
+}
+
+// HostAdapters - TypeHostAdaptersCounterRecord
+type HostAdapters struct {
+	AdaptersLen uint32
+	Adapters    []HostAdapter `lengthLookUp:"AdaptersLen"`
+}
+
+func (f HostAdapters) String() string {
+	type X HostAdapters
+	x := X(f)
+	return fmt.Sprintf("HostAdapters: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f HostAdapters) RecordName() string {
+	return "HostAdapters"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f HostAdapters) RecordType() int {
+	return TypeHostAdaptersCounterRecord
+}
+
+func (f HostAdapters) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.AdaptersLen)
+	for _, adapter := range f.Adapters {
+		size += binary.Size(adapter.IfIndex)
+		size += binary.Size(adapter.MACAddressesLen)
+		size += binary.Size(adapter.MACAddresses)
+	}
+
+	return size
+}
+
+func (f HostAdapters) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// HostParent - TypeHostParentCounterRecord
+type HostParent struct {
+	ContainerType  uint32 /* sFlowDataSource type */
+	ContainerIndex uint32 /* sFlowDataSource index */
+}
+
+func (f HostParent) String() string {
+	type X HostParent
+	x := X(f)
+	return fmt.Sprintf("HostParent: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f HostParent) RecordName() string {
+	return "HostParent"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f HostParent) RecordType() int {
+	return TypeHostParentCounterRecord
+}
+
+func (f HostParent) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f HostParent) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
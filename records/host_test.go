@@ -0,0 +1,73 @@
+package records
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDecodeHostCounterRecords(t *testing.T) {
+	recs := []Record{
+		HostDescriptionCounter{
+			HostnameLen:  7,
+			Hostname:     []byte("fractal"),
+			UUID:         [16]byte{0x20, 0xd1, 0x1d, 0x01, 15: 0xa3},
+			MachineType:  MachineTypeX86_64,
+			OSName:       OSNameLinux,
+			OSReleaseLen: 17,
+			OSRelease:    []byte("3.13.0-29-generic"),
+		},
+		HostAdapters{
+			AdaptersLen: 2,
+			Adapters: []HostAdapter{
+				{IfIndex: 2, MACAddressesLen: 1, MACAddresses: []HostAdapterMAC{{Addr: [6]byte{0x00, 0x00, 0x5e, 0x00, 0x53, 0x01}}}},
+				{IfIndex: 3, MACAddressesLen: 2, MACAddresses: []HostAdapterMAC{
+					{Addr: [6]byte{0x00, 0x00, 0x5e, 0x00, 0x53, 0x02}},
+					{Addr: [6]byte{0x00, 0x00, 0x5e, 0x00, 0x53, 0x03}},
+				}},
+			},
+		},
+		HostParent{ContainerType: 2, ContainerIndex: 1},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+}
+
+func TestHostDescriptionCounterMaxLength(t *testing.T) {
+	hostname := strings.Repeat("a", 65)
+	rec := HostDescriptionCounter{
+		HostnameLen: uint32(len(hostname)),
+		Hostname:    []byte(hostname),
+	}
+
+	if err := rec.Encode(&bytes.Buffer{}); err == nil {
+		t.Error("expected an error encoding a hostname longer than 64 bytes")
+	}
+
+	b := &bytes.Buffer{}
+	binary.Write(b, binary.BigEndian, uint32(len(hostname)))
+	b.WriteString(hostname)
+
+	if _, err := DecodeCounter(b, TypeHostDescriptionCounterRecord); err == nil {
+		t.Error("expected an error decoding a hostname longer than 64 bytes")
+	}
+}
+
+func TestDecodeHostAdaptersOversizedLength(t *testing.T) {
+	tests := map[string][]uint32{
+		"adapters":      {0xffffffff, 2, 1},
+		"mac addresses": {1, 2, 0x20000000, 0x00005e00, 0x53010000},
+	}
+
+	for name, data := range tests {
+		b := &bytes.Buffer{}
+		binary.Write(b, binary.BigEndian, data)
+
+		if _, err := DecodeCounter(b, TypeHostAdaptersCounterRecord); err == nil {
+			t.Errorf("%s: expected an error decoding a length larger than the record", name)
+		}
+	}
+}
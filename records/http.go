@@ -1,6 +1,8 @@
 package records
 
 import (
+	"encoding/binary"
+	"fmt"
 	"io"
 )
 
@@ -22,25 +24,31 @@ type HTTPRequestFlow struct {
 	Method       uint32
 	Protocol     uint32 /* HTTP protocol version: Encoded as major_number * 1000 + minor_number. e.g. HTTP1.1 is encoded as 1001 */
 	URILen       uint32
-	URI          []byte `lengthLookUp:"URILen"` /* URI exactly as it came from the client */
+	URI          []byte `lengthLookUp:"URILen" maxLength:"255"` /* URI exactly as it came from the client */
 	HostLen      uint32
-	Host         []byte `lengthLookUp:"HostLen"` /* Host value from request header */
+	Host         []byte `lengthLookUp:"HostLen" maxLength:"64"` /* Host value from request header */
 	RefererLen   uint32
-	Referer      []byte `lengthLookUp:"RefererLen"` /* Referer value from request header */
+	Referer      []byte `lengthLookUp:"RefererLen" maxLength:"255"` /* Referer value from request header */
 	UserAgentLen uint32
-	UserAgent    []byte `lengthLookUp:"UserAgentLen"` /* User-Agent value from request header */
+	UserAgent    []byte `lengthLookUp:"UserAgentLen" maxLength:"128"` /* User-Agent value from request header */
 	XFFLen       uint32
-	XFF          []byte `lengthLookUp:"XFFLen"` /* X-Forwarded-For value from request header */
+	XFF          []byte `lengthLookUp:"XFFLen" maxLength:"64"` /* X-Forwarded-For value from request header */
 	AuthUserLen  uint32
-	AuthUser     []byte `lengthLookUp:"AuthUserLen"` /* RFC 1413 identity of user*/
+	AuthUser     []byte `lengthLookUp:"AuthUserLen" maxLength:"32"` /* RFC 1413 identity of user*/
 	MimeTypeLen  uint32
-	MimeType     []byte `lengthLookUp:"MimeTypeLen"` /* Mime-Type of response */
+	MimeType     []byte `lengthLookUp:"MimeTypeLen" maxLength:"64"` /* Mime-Type of response */
 	ReqBytes     uint64 /* Content-Length of request */
 	RespBytes    uint64 /* Content-Length of response */
 	Duration     uint32 /* duration of the operation (in microseconds) */
 	Status       uint32 /* HTTP status code */
 }
 
+func (f HTTPRequestFlow) String() string {
+	type X HTTPRequestFlow
+	x := X(f)
+	return fmt.Sprintf("HTTPRequestFlow: %+v", x)
+}
+
 // RecordName returns the Name of this flow record
 func (f HTTPRequestFlow) RecordName() string {
 	return "HTTPRequestFlow"
@@ -51,10 +59,49 @@ func (f HTTPRequestFlow) RecordType() int {
 	return TypeHTTPRequestFlowRecord
 }
 
+func (f HTTPRequestFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.Method)
+	size += binary.Size(f.Protocol)
+	size += binary.Size(f.URILen)
+	size += len(f.URI) + xdrPadding(len(f.URI))
+	size += binary.Size(f.HostLen)
+	size += len(f.Host) + xdrPadding(len(f.Host))
+	size += binary.Size(f.RefererLen)
+	size += len(f.Referer) + xdrPadding(len(f.Referer))
+	size += binary.Size(f.UserAgentLen)
+	size += len(f.UserAgent) + xdrPadding(len(f.UserAgent))
+	size += binary.Size(f.XFFLen)
+	size += len(f.XFF) + xdrPadding(len(f.XFF))
+	size += binary.Size(f.AuthUserLen)
+	size += len(f.AuthUser) + xdrPadding(len(f.AuthUser))
+	size += binary.Size(f.MimeTypeLen)
+	size += len(f.MimeType) + xdrPadding(len(f.MimeType))
+	size += binary.Size(f.ReqBytes)
+	size += binary.Size(f.RespBytes)
+	size += binary.Size(f.Duration)
+	size += binary.Size(f.Status)
+
+	return size
+}
+
 func (f HTTPRequestFlow) Encode(w io.Writer) error {
 	var err error
 
-	return err
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
 }
 
 // HTTPCounters - TypeHTTPCounterRecord
@@ -76,24 +123,167 @@ type HTTPCounter struct {
 	StatusOtherCount   uint32
 }
 
-// RecordName returns the Name of this flow record
+func (f HTTPCounter) String() string {
+	type X HTTPCounter
+	x := X(f)
+	return fmt.Sprintf("HTTPCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
 func (f HTTPCounter) RecordName() string {
 	return "HTTPCounter"
 }
 
-// RecordType returns the ID of the sflow flow record
+// RecordType returns the ID of the sflow counter record
 func (f HTTPCounter) RecordType() int {
 	return TypeHTTPCounterRecord
 }
 
+func (f HTTPCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
 func (f HTTPCounter) Encode(w io.Writer) error {
 	var err error
 
-	return err
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
 }
 
-// ExtendedProxyRequest - TypeHTTPExtendedProxyFlowRecord
+// ExtendedProxyRequestFlow - TypeHTTPExtendedProxyFlowRecord
 type ExtendedProxyRequestFlow struct {
-	//string<255> uri;           /* URI in request to downstream server */
-	//string<64>  host;          /* Host in request to downstream server */
+	URILen  uint32
+	URI     []byte `lengthLookUp:"URILen" maxLength:"255"` /* URI in request to downstream server */
+	HostLen uint32
+	Host    []byte `lengthLookUp:"HostLen" maxLength:"64"` /* Host in request to downstream server */
+}
+
+func (f ExtendedProxyRequestFlow) String() string {
+	type X ExtendedProxyRequestFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedProxyRequestFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedProxyRequestFlow) RecordName() string {
+	return "ExtendedProxyRequestFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedProxyRequestFlow) RecordType() int {
+	return TypeHTTPExtendedProxyFlowRecord
+}
+
+func (f ExtendedProxyRequestFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.URILen)
+	size += len(f.URI) + xdrPadding(len(f.URI))
+	size += binary.Size(f.HostLen)
+	size += len(f.Host) + xdrPadding(len(f.Host))
+
+	return size
+}
+
+func (f ExtendedProxyRequestFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// Navigation types of ExtendedNavigationTimingFlow
+const (
+	NavigationTypeNavigate    = 0
+	NavigationTypeReload      = 1
+	NavigationTypeBackForward = 2
+	NavigationTypeReserved    = 255
+)
+
+// ExtendedNavigationTimingFlow - TypeExtendedNavigationTimingFlowRecord
+// The timestamps are milliseconds relative to NavigationStart as reported by the browser Navigation Timing API
+type ExtendedNavigationTimingFlow struct {
+	Type                       uint32 /* type of navigation, see NavigationType* */
+	RedirectCount              uint32
+	NavigationStart            uint32
+	UnloadEventStart           uint32
+	UnloadEventEnd             uint32
+	RedirectStart              uint32
+	RedirectEnd                uint32
+	FetchStart                 uint32
+	DomainLookupStart          uint32
+	DomainLookupEnd            uint32
+	ConnectStart               uint32
+	ConnectEnd                 uint32
+	SecureConnectionStart      uint32
+	RequestStart               uint32
+	ResponseStart              uint32
+	ResponseEnd                uint32
+	DOMLoading                 uint32
+	DOMInteractive             uint32
+	DOMContentLoadedEventStart uint32
+	DOMContentLoadedEventEnd   uint32
+	DOMComplete                uint32
+	LoadEventStart             uint32
+	LoadEventEnd               uint32
+}
+
+func (f ExtendedNavigationTimingFlow) String() string {
+	type X ExtendedNavigationTimingFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedNavigationTimingFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedNavigationTimingFlow) RecordName() string {
+	return "ExtendedNavigationTimingFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedNavigationTimingFlow) RecordType() int {
+	return TypeExtendedNavigationTimingFlowRecord
+}
+
+func (f ExtendedNavigationTimingFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedNavigationTimingFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
 }
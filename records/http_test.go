@@ -0,0 +1,63 @@
+package records
+
+import (
+	"testing"
+)
+
+func TestEncodeDecodeHTTPFlowRecords(t *testing.T) {
+	recs := []Record{
+		HTTPRequestFlow{
+			Method:       HTTPGet,
+			Protocol:     1001,
+			URILen:       11,
+			URI:          []byte("/index.html"),
+			HostLen:      11,
+			Host:         []byte("example.com"),
+			UserAgentLen: 10,
+			UserAgent:    []byte("curl/8.5.0"),
+			XFFLen:       9,
+			XFF:          []byte("192.0.2.1"),
+			MimeTypeLen:  9,
+			MimeType:     []byte("text/html"),
+			RespBytes:    4096,
+			Duration:     1200,
+			Status:       200,
+		},
+		ExtendedProxyRequestFlow{
+			URILen:  6,
+			URI:     []byte("/index"),
+			HostLen: 13,
+			Host:    []byte("backend.local"),
+		},
+		ExtendedNavigationTimingFlow{
+			Type:            NavigationTypeReload,
+			NavigationStart: 0,
+			FetchStart:      2,
+			RequestStart:    40,
+			ResponseStart:   90,
+			ResponseEnd:     120,
+			DOMComplete:     400,
+			LoadEventEnd:    420,
+		},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+}
+
+func TestEncodeDecodeHTTPCounter(t *testing.T) {
+	rec := HTTPCounter{
+		MethodGetCount:  1000,
+		MethodPostCount: 200,
+		Status2XXCount:  1150,
+		Status4XXCount:  40,
+		Status5XXCount:  10,
+	}
+
+	roundTrip(t, rec)
+
+	if size := rec.calculateBinarySize(); size != 60 {
+		t.Errorf("expected record length 60, got %d", size)
+	}
+}
@@ -0,0 +1,439 @@
+package records
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// IEEE80211Version is the version of the 802.11 protocol
+type IEEE80211Version uint32
+
+// 802.11 protocol versions
+const (
+	IEEE80211VersionA IEEE80211Version = 1
+	IEEE80211VersionB IEEE80211Version = 2
+	IEEE80211VersionG IEEE80211Version = 3
+	IEEE80211VersionN IEEE80211Version = 4
+)
+
+func (v IEEE80211Version) String() string {
+	switch v {
+	case IEEE80211VersionA:
+		return "802.11a"
+	case IEEE80211VersionB:
+		return "802.11b"
+	case IEEE80211VersionG:
+		return "802.11g"
+	case IEEE80211VersionN:
+		return "802.11n"
+	}
+
+	return fmt.Sprintf("IEEE80211Version(%d)", uint32(v))
+}
+
+// Extended80211PayloadFlow - TypeExtended80211PayloadFlowRecord
+type Extended80211PayloadFlow struct {
+	CipherSuite uint32 /* encryption scheme used for this packet, OUI and suite type */
+	DataLen     uint32
+	Data        []byte `lengthLookUp:"DataLen"` /* unencrypted bytes from the payload */
+}
+
+func (f Extended80211PayloadFlow) String() string {
+	type X Extended80211PayloadFlow
+	x := X(f)
+	return fmt.Sprintf("Extended80211PayloadFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f Extended80211PayloadFlow) RecordName() string {
+	return "Extended80211PayloadFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f Extended80211PayloadFlow) RecordType() int {
+	return TypeExtended80211PayloadFlowRecord
+}
+
+func (f Extended80211PayloadFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.CipherSuite)
+	size += binary.Size(f.DataLen)
+	size += len(f.Data) + xdrPadding(len(f.Data))
+
+	return size
+}
+
+func (f Extended80211PayloadFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// Extended80211RxFlow - TypeExtended80211RxFlowRecord
+type Extended80211RxFlow struct {
+	SSIDLen        uint32
+	SSID           []byte  `lengthLookUp:"SSIDLen" maxLength:"32"` /* SSID string, at most 32 bytes */
+	BSSID          [6]byte /* BSSID */
+	_              [2]byte
+	Version        IEEE80211Version /* version of 802.11 protocol */
+	Channel        uint32           /* channel number */
+	Speed          uint64
+	RSNI           uint32 /* received signal to noise ratio, see dot11FrameRprtRSNI */
+	RCPI           uint32 /* received channel power, see dot11FrameRprtLastRCPI */
+	PacketDuration uint32 /* amount of time that the successfully received packet occupied the RF medium, in microseconds */
+}
+
+func (f Extended80211RxFlow) String() string {
+	type X Extended80211RxFlow
+	x := X(f)
+	return fmt.Sprintf("Extended80211RxFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f Extended80211RxFlow) RecordName() string {
+	return "Extended80211RxFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f Extended80211RxFlow) RecordType() int {
+	return TypeExtended80211RxFlowRecord
+}
+
+func (f Extended80211RxFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.SSIDLen)
+	size += len(f.SSID) + xdrPadding(len(f.SSID))
+	size += binary.Size(f.BSSID) + 2
+	size += binary.Size(f.Version)
+	size += binary.Size(f.Channel)
+	size += binary.Size(f.Speed)
+	size += binary.Size(f.RSNI)
+	size += binary.Size(f.RCPI)
+	size += binary.Size(f.PacketDuration)
+
+	return size
+}
+
+func (f Extended80211RxFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// Extended80211TxFlow - TypeExtended80211TxFlowRecord
+type Extended80211TxFlow struct {
+	SSIDLen         uint32
+	SSID            []byte  `lengthLookUp:"SSIDLen" maxLength:"32"` /* SSID string, at most 32 bytes */
+	BSSID           [6]byte /* BSSID */
+	_               [2]byte
+	Version         IEEE80211Version /* version of 802.11 protocol */
+	Transmissions   uint32           /* number of transmissions for sampled packet, 0 if unknown */
+	PacketDuration  uint32           /* amount of time that the successful transmission occupied the RF medium, in microseconds */
+	RetransDuration uint32           /* amount of time that failed transmission attempts occupied the RF medium, in microseconds */
+	Channel         uint32           /* channel number */
+	Speed           uint64
+	Power           uint32 /* transmit power in mW */
+}
+
+func (f Extended80211TxFlow) String() string {
+	type X Extended80211TxFlow
+	x := X(f)
+	return fmt.Sprintf("Extended80211TxFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f Extended80211TxFlow) RecordName() string {
+	return "Extended80211TxFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f Extended80211TxFlow) RecordType() int {
+	return TypeExtended80211TxFlowRecord
+}
+
+func (f Extended80211TxFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.SSIDLen)
+	size += len(f.SSID) + xdrPadding(len(f.SSID))
+	size += binary.Size(f.BSSID) + 2
+	size += binary.Size(f.Version)
+	size += binary.Size(f.Transmissions)
+	size += binary.Size(f.PacketDuration)
+	size += binary.Size(f.RetransDuration)
+	size += binary.Size(f.Channel)
+	size += binary.Size(f.Speed)
+	size += binary.Size(f.Power)
+
+	return size
+}
+
+func (f Extended80211TxFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// Extended80211PDU is a single 802.11 PDU of an aggregated frame, described by its own flow records
+type Extended80211PDU struct {
+	Records []Record
+}
+
+// Extended80211AggregationFlow - TypeExtended80211AggregationFlowRecord
+type Extended80211AggregationFlow struct {
+	PDUs []Extended80211PDU
+}
+
+func (f Extended80211AggregationFlow) String() string {
+	type X Extended80211AggregationFlow
+	x := X(f)
+	return fmt.Sprintf("Extended80211AggregationFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f Extended80211AggregationFlow) RecordName() string {
+	return "Extended80211AggregationFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f Extended80211AggregationFlow) RecordType() int {
+	return TypeExtended80211AggregationFlowRecord
+}
+
+// DecodeExtended80211AggregationFlow decodes the PDUs of an aggregated 802.11 frame.
+// Flow records of unknown types within a PDU are skipped.
+func DecodeExtended80211AggregationFlow(r io.Reader) (Extended80211AggregationFlow, error) {
+	var f Extended80211AggregationFlow
+	var numPDUs uint32
+
+	if err := binary.Read(r, binary.BigEndian, &numPDUs); err != nil {
+		return f, err
+	}
+
+	for i := uint32(0); i < numPDUs; i++ {
+		var pdu Extended80211PDU
+		var numRecords uint32
+
+		if err := binary.Read(r, binary.BigEndian, &numRecords); err != nil {
+			return f, err
+		}
+
+		for j := uint32(0); j < numRecords; j++ {
+			format, length := uint32(0), uint32(0)
+
+			if err := binary.Read(r, binary.BigEndian, &format); err != nil {
+				return f, err
+			}
+
+			if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+				return f, err
+			}
+
+			lr := &io.LimitedReader{R: r, N: int64(length)}
+
+			if _, found := flowRecordTypes[format]; found {
+				rec, err := DecodeFlow(lr, format)
+				if err != nil {
+					return f, err
+				}
+				pdu.Records = append(pdu.Records, rec)
+			}
+
+			// Skip unknown records and anything the decoder did not consume
+			if _, err := io.Copy(io.Discard, lr); err != nil {
+				return f, err
+			}
+		}
+
+		f.PDUs = append(f.PDUs, pdu)
+	}
+
+	return f, nil
+}
+
+func (f Extended80211AggregationFlow) Encode(w io.Writer) error {
+	var err error
+
+	// The nested records are encoded first to determine the record length
+	buf := &bytes.Buffer{}
+
+	err = binary.Write(buf, binary.BigEndian, uint32(len(f.PDUs)))
+	if err != nil {
+		return err
+	}
+
+	for _, pdu := range f.PDUs {
+		err = binary.Write(buf, binary.BigEndian, uint32(len(pdu.Records)))
+		if err != nil {
+			return err
+		}
+
+		for _, rec := range pdu.Records {
+			err = rec.Encode(buf)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	err = binary.Write(w, binary.BigEndian, uint32(buf.Len()))
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(buf.Bytes())
+
+	return err
+}
+
+// IEEE80211Counter - TypeIEEE80211CounterRecord
+type IEEE80211Counter struct {
+	Dot11TransmittedFragmentCount       uint32
+	Dot11MulticastTransmittedFrameCount uint32
+	Dot11FailedCount                    uint32
+	Dot11RetryCount                     uint32
+	Dot11MultipleRetryCount             uint32
+	Dot11FrameDuplicateCount            uint32
+	Dot11RTSSuccessCount                uint32
+	Dot11RTSFailureCount                uint32
+	Dot11ACKFailureCount                uint32
+	Dot11ReceivedFragmentCount          uint32
+	Dot11MulticastReceivedFrameCount    uint32
+	Dot11FCSErrorCount                  uint32
+	Dot11TransmittedFrameCount          uint32
+	Dot11WEPUndecryptableCount          uint32
+	Dot11QoSDiscardedFragmentCount      uint32
+	Dot11AssociatedStationCount         uint32
+	Dot11QoSCFPollsReceivedCount        uint32
+	Dot11QoSCFPollsUnusedCount          uint32
+	Dot11QoSCFPollsUnusableCount        uint32
+	Dot11QoSCFPollsLostCount            uint32
+}
+
+func (f IEEE80211Counter) String() string {
+	type X IEEE80211Counter
+	x := X(f)
+	return fmt.Sprintf("IEEE80211Counter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f IEEE80211Counter) RecordName() string {
+	return "IEEE80211Counter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f IEEE80211Counter) RecordType() int {
+	return TypeIEEE80211CounterRecord
+}
+
+func (f IEEE80211Counter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f IEEE80211Counter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// RadioUtilizationCounter - TypeRadioUtilizationCounterRecord
+type RadioUtilizationCounter struct {
+	ElapsedTime       uint32 /* elapsed time in ms */
+	OnChannelTime     uint32 /* time in ms spent on channel */
+	OnChannelBusyTime uint32 /* time in ms spent on channel and busy */
+}
+
+func (f RadioUtilizationCounter) String() string {
+	type X RadioUtilizationCounter
+	x := X(f)
+	return fmt.Sprintf("RadioUtilizationCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f RadioUtilizationCounter) RecordName() string {
+	return "RadioUtilizationCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f RadioUtilizationCounter) RecordType() int {
+	return TypeRadioUtilizationCounterRecord
+}
+
+func (f RadioUtilizationCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f RadioUtilizationCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
@@ -0,0 +1,108 @@
+package records
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDecodeExtended80211FlowRecords(t *testing.T) {
+	rx := Extended80211RxFlow{
+		SSIDLen:        6,
+		SSID:           []byte("campus"),
+		BSSID:          [6]byte{0x00, 0x00, 0x5e, 0x00, 0x53, 0x10},
+		Version:        IEEE80211VersionN,
+		Channel:        36,
+		Speed:          300000000,
+		RSNI:           40,
+		RCPI:           120,
+		PacketDuration: 250,
+	}
+
+	recs := []Record{
+		Extended80211PayloadFlow{
+			CipherSuite: 0x000fac04, // CCMP
+			DataLen:     3,
+			Data:        []byte{0xaa, 0xaa, 0x03},
+		},
+		rx,
+		Extended80211TxFlow{
+			SSIDLen:         5,
+			SSID:            []byte("guest"),
+			BSSID:           [6]byte{0x00, 0x00, 0x5e, 0x00, 0x53, 0x11},
+			Version:         IEEE80211VersionG,
+			Transmissions:   2,
+			PacketDuration:  300,
+			RetransDuration: 280,
+			Channel:         6,
+			Speed:           54000000,
+			Power:           100,
+		},
+		Extended80211AggregationFlow{
+			PDUs: []Extended80211PDU{
+				{Records: []Record{rx}},
+				{Records: []Record{
+					SampledIPv4Flow{
+						Length:   60,
+						Protocol: IPProtocolTCP,
+						SrcIP:    net.IP{192, 0, 2, 1},
+						DstIP:    net.IP{192, 0, 2, 2},
+						SrcPort:  49152,
+						DstPort:  22,
+					},
+				}},
+			},
+		},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+}
+
+func TestEncodeDecodeIEEE80211CounterRecords(t *testing.T) {
+	recs := []Record{
+		IEEE80211Counter{
+			Dot11TransmittedFragmentCount: 1000,
+			Dot11FailedCount:              3,
+			Dot11RetryCount:               12,
+			Dot11AssociatedStationCount:   25,
+			Dot11QoSCFPollsLostCount:      1,
+		},
+		RadioUtilizationCounter{
+			ElapsedTime:       30000,
+			OnChannelTime:     29000,
+			OnChannelBusyTime: 12000,
+		},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+}
+
+func TestExtended80211FlowSSIDMaxLength(t *testing.T) {
+	ssid := strings.Repeat("a", 33)
+
+	recs := []Record{
+		Extended80211RxFlow{SSIDLen: uint32(len(ssid)), SSID: []byte(ssid)},
+		Extended80211TxFlow{SSIDLen: uint32(len(ssid)), SSID: []byte(ssid)},
+	}
+
+	for _, rec := range recs {
+		if err := rec.Encode(&bytes.Buffer{}); err == nil {
+			t.Errorf("%s: expected an error encoding an SSID longer than 32 bytes", rec.RecordName())
+		}
+
+		b := &bytes.Buffer{}
+		binary.Write(b, binary.BigEndian, uint32(len(ssid)))
+		b.WriteString(ssid)
+		b.Write(make([]byte, 64))
+
+		if _, err := DecodeFlow(b, uint32(rec.RecordType())); err == nil {
+			t.Errorf("%s: expected an error decoding an SSID longer than 32 bytes", rec.RecordName())
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// ExtendedIBLRHFlow - TypeExtendedIBLRHFlowRecord
+type ExtendedIBLRHFlow struct {
+	SrcVL   uint32 /* source virtual lane */
+	SrcSL   uint32 /* source service level */
+	SrcDLID uint32 /* source destination-local-ID */
+	SrcSLID uint32 /* source source-local-ID */
+	SrcLNH  uint32 /* source next header */
+}
+
+func (f ExtendedIBLRHFlow) String() string {
+	type X ExtendedIBLRHFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedIBLRHFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedIBLRHFlow) RecordName() string {
+	return "ExtendedIBLRHFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedIBLRHFlow) RecordType() int {
+	return TypeExtendedIBLRHFlowRecord
+}
+
+func (f ExtendedIBLRHFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedIBLRHFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// ExtendedIBGRHFlow - TypeExtendedIBGRHFlowRecord
+type ExtendedIBGRHFlow struct {
+	FlowLabel  uint32   /* flow label */
+	TC         uint32   /* traffic class */
+	SrcGID     [16]byte /* source global identifier */
+	DstGID     [16]byte /* destination global identifier */
+	NextHeader uint32   /* next header type */
+	Length     uint32   /* payload length */
+}
+
+func (f ExtendedIBGRHFlow) String() string {
+	type X ExtendedIBGRHFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedIBGRHFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedIBGRHFlow) RecordName() string {
+	return "ExtendedIBGRHFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedIBGRHFlow) RecordType() int {
+	return TypeExtendedIBGRHFlowRecord
+}
+
+func (f ExtendedIBGRHFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedIBGRHFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// ExtendedIBBRHFlow - TypeExtendedIBBRHFlowRecord
+type ExtendedIBBRHFlow struct {
+	PKey   uint32 /* partition key */
+	DstQP  uint32 /* destination queue pair */
+	Opcode uint32 /* IBA packet type */
+}
+
+func (f ExtendedIBBRHFlow) String() string {
+	type X ExtendedIBBRHFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedIBBRHFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedIBBRHFlow) RecordName() string {
+	return "ExtendedIBBRHFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedIBBRHFlow) RecordType() int {
+	return TypeExtendedIBBRHFlowRecord
+}
+
+func (f ExtendedIBBRHFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedIBBRHFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// IBCounter - TypeIBCounterRecord
+type IBCounter struct {
+	PortXmitPkts                 uint32
+	PortRcvPkts                  uint32
+	SymbolErrorCounter           uint32
+	LinkErrorRecoveryCounter     uint32
+	LinkDownedCounter            uint32
+	PortRcvErrors                uint32
+	PortRcvRemotePhysicalErrors  uint32
+	PortRcvSwitchRelayErrors     uint32
+	PortXmitDiscards             uint32
+	PortXmitConstraintErrors     uint32
+	PortRcvConstraintErrors      uint32
+	LocalLinkIntegrityErrors     uint32
+	ExcessiveBufferOverrunErrors uint32
+	VL15Dropped                  uint32
+	PortXmitWait                 uint32
+}
+
+func (f IBCounter) String() string {
+	type X IBCounter
+	x := X(f)
+	return fmt.Sprintf("IBCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f IBCounter) RecordName() string {
+	return "IBCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f IBCounter) RecordType() int {
+	return TypeIBCounterRecord
+}
+
+func (f IBCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f IBCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
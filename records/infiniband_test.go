@@ -0,0 +1,28 @@
+package records
+
+import (
+	"testing"
+)
+
+func TestEncodeDecodeInfiniBandRecords(t *testing.T) {
+	flows := []Record{
+		ExtendedIBLRHFlow{SrcVL: 0, SrcSL: 3, SrcDLID: 0x0012, SrcSLID: 0x0034, SrcLNH: IBLinkNextHeaderGlobal},
+		ExtendedIBGRHFlow{
+			FlowLabel:  0x12345,
+			TC:         4,
+			SrcGID:     [16]byte{0xfe, 0x80, 15: 0x01},
+			DstGID:     [16]byte{0xfe, 0x80, 15: 0x02},
+			NextHeader: IBNextHeaderBTH,
+			Length:     256,
+		},
+		ExtendedIBBRHFlow{PKey: 0xffff, DstQP: 0x000102, Opcode: 0x04},
+	}
+
+	for _, rec := range flows {
+		roundTrip(t, rec)
+	}
+
+	rec := IBCounter{PortXmitPkts: 1000, PortRcvPkts: 2000, SymbolErrorCounter: 1, PortXmitWait: 42}
+
+	roundTrip(t, rec)
+}
@@ -0,0 +1,125 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// JVMRuntimeCounter - TypeJVMRuntimeCounterRecord
+type JVMRuntimeCounter struct {
+	VMNameLen    uint32
+	VMName       XDRString `lengthLookUp:"VMNameLen" maxLength:"64"` /* vm name */
+	VMVendorLen  uint32
+	VMVendor     XDRString `lengthLookUp:"VMVendorLen" maxLength:"32"` /* the vendor for the JVM */
+	VMVersionLen uint32
+	VMVersion    XDRString `lengthLookUp:"VMVersionLen" maxLength:"32"` /* the version for the JVM */
+}
+
+func (f JVMRuntimeCounter) String() string {
+	type X JVMRuntimeCounter
+	x := X(f)
+	return fmt.Sprintf("JVMRuntimeCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f JVMRuntimeCounter) RecordName() string {
+	return "JVMRuntimeCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f JVMRuntimeCounter) RecordType() int {
+	return TypeJVMRuntimeCounterRecord
+}
+
+func (f JVMRuntimeCounter) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.VMNameLen)
+	size += len(f.VMName) + xdrPadding(len(f.VMName))
+	size += binary.Size(f.VMVendorLen)
+	size += len(f.VMVendor) + xdrPadding(len(f.VMVendor))
+	size += binary.Size(f.VMVersionLen)
+	size += len(f.VMVersion) + xdrPadding(len(f.VMVersion))
+
+	return size
+}
+
+func (f JVMRuntimeCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// JVMStatisticsCounter - TypeJVMStatisticsCounterRecord
+type JVMStatisticsCounter struct {
+	HeapInitial      uint64 /* initial heap memory requested */
+	HeapUsed         uint64 /* current heap memory usage */
+	HeapCommitted    uint64 /* heap memory currently committed */
+	HeapMax          uint64 /* max heap space */
+	NonHeapInitial   uint64 /* initial non heap memory */
+	NonHeapUsed      uint64 /* current non heap memory usage */
+	NonHeapCommitted uint64 /* committed non heap memory */
+	NonHeapMax       uint64 /* max non-heap space */
+	GCCount          uint32 /* total number of collections that have occurred */
+	GCTime           uint32 /* approximate accumulated collection elapsed time in ms */
+	ClassesLoaded    uint32 /* number of classes currently loaded in vm */
+	ClassesTotal     uint32 /* total number of classes loaded since vm started */
+	ClassesUnloaded  uint32 /* total number of classes unloaded since vm started */
+	CompilationTime  uint32 /* total accumulated time spent in compilation in ms */
+	ThreadNumLive    uint32 /* current number of live threads */
+	ThreadNumDaemon  uint32 /* current number of live daemon threads */
+	ThreadNumStarted uint32 /* total threads started since vm started */
+	FDOpenCount      uint32 /* number of open file descriptors */
+	FDMaxCount       uint32 /* max number of file descriptors */
+}
+
+func (f JVMStatisticsCounter) String() string {
+	type X JVMStatisticsCounter
+	x := X(f)
+	return fmt.Sprintf("JVMStatisticsCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f JVMStatisticsCounter) RecordName() string {
+	return "JVMStatisticsCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f JVMStatisticsCounter) RecordType() int {
+	return TypeJVMStatisticsCounterRecord
+}
+
+func (f JVMStatisticsCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f JVMStatisticsCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
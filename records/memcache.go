@@ -0,0 +1,258 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// MemcacheCounter - TypeMemcacheCounterRecord
+type MemcacheCounter struct {
+	CmdSet               uint32
+	CmdTouch             uint32
+	CmdFlush             uint32
+	GetHits              uint32
+	GetMisses            uint32
+	DeleteHits           uint32
+	DeleteMisses         uint32
+	IncrHits             uint32
+	IncrMisses           uint32
+	DecrHits             uint32
+	DecrMisses           uint32
+	CASHits              uint32
+	CASMisses            uint32
+	CASBadval            uint32
+	AuthCmds             uint32
+	AuthErrors           uint32
+	Threads              uint32
+	ConnYields           uint32
+	ListenDisabledNum    uint32
+	CurrConnections      uint32
+	RejectedConnections  uint32
+	TotalConnections     uint32
+	ConnectionStructures uint32
+	Evictions            uint32
+	Reclaimed            uint32
+	CurrItems            uint32
+	TotalItems           uint32
+	BytesRead            uint64
+	BytesWritten         uint64
+	Bytes                uint64
+	LimitMaxbytes        uint64
+}
+
+func (f MemcacheCounter) String() string {
+	type X MemcacheCounter
+	x := X(f)
+	return fmt.Sprintf("MemcacheCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f MemcacheCounter) RecordName() string {
+	return "MemcacheCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f MemcacheCounter) RecordType() int {
+	return TypeMemcacheCounterRecord
+}
+
+func (f MemcacheCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f MemcacheCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// MemcacheProtocol is the wire protocol of a memcache operation, see MemcacheOperationFlow.
+type MemcacheProtocol uint32
+
+// Memcache protocols as defined in the sFlow Memcache Structures
+const (
+	MemcacheProtocolOther  MemcacheProtocol = 0
+	MemcacheProtocolASCII  MemcacheProtocol = 1
+	MemcacheProtocolBinary MemcacheProtocol = 2
+)
+
+var memcacheProtocolNames = map[MemcacheProtocol]string{
+	MemcacheProtocolOther:  "OTHER",
+	MemcacheProtocolASCII:  "ASCII",
+	MemcacheProtocolBinary: "BINARY",
+}
+
+// String returns the name of the value as used in the sFlow specification.
+func (v MemcacheProtocol) String() string {
+	if name, found := memcacheProtocolNames[v]; found {
+		return name
+	}
+
+	return fmt.Sprintf("MemcacheProtocol(%d)", uint32(v))
+}
+
+// MemcacheCmd is the command of a memcache operation, see MemcacheOperationFlow.
+type MemcacheCmd uint32
+
+// Memcache commands as defined in the sFlow Memcache Structures
+const (
+	MemcacheCmdOther   MemcacheCmd = 0
+	MemcacheCmdGet     MemcacheCmd = 1
+	MemcacheCmdSet     MemcacheCmd = 2
+	MemcacheCmdAdd     MemcacheCmd = 3
+	MemcacheCmdReplace MemcacheCmd = 4
+	MemcacheCmdAppend  MemcacheCmd = 5
+	MemcacheCmdPrepend MemcacheCmd = 6
+	MemcacheCmdCAS     MemcacheCmd = 7
+	MemcacheCmdGets    MemcacheCmd = 8
+	MemcacheCmdIncr    MemcacheCmd = 9
+	MemcacheCmdDecr    MemcacheCmd = 10
+	MemcacheCmdDelete  MemcacheCmd = 11
+	MemcacheCmdStats   MemcacheCmd = 12
+	MemcacheCmdFlush   MemcacheCmd = 13
+	MemcacheCmdVersion MemcacheCmd = 14
+	MemcacheCmdQuit    MemcacheCmd = 15
+	MemcacheCmdTouch   MemcacheCmd = 16
+)
+
+var memcacheCmdNames = map[MemcacheCmd]string{
+	MemcacheCmdOther:   "OTHER",
+	MemcacheCmdGet:     "GET",
+	MemcacheCmdSet:     "SET",
+	MemcacheCmdAdd:     "ADD",
+	MemcacheCmdReplace: "REPLACE",
+	MemcacheCmdAppend:  "APPEND",
+	MemcacheCmdPrepend: "PREPEND",
+	MemcacheCmdCAS:     "CAS",
+	MemcacheCmdGets:    "GETS",
+	MemcacheCmdIncr:    "INCR",
+	MemcacheCmdDecr:    "DECR",
+	MemcacheCmdDelete:  "DELETE",
+	MemcacheCmdStats:   "STATS",
+	MemcacheCmdFlush:   "FLUSH",
+	MemcacheCmdVersion: "VERSION",
+	MemcacheCmdQuit:    "QUIT",
+	MemcacheCmdTouch:   "TOUCH",
+}
+
+// String returns the name of the value as used in the sFlow specification.
+func (v MemcacheCmd) String() string {
+	if name, found := memcacheCmdNames[v]; found {
+		return name
+	}
+
+	return fmt.Sprintf("MemcacheCmd(%d)", uint32(v))
+}
+
+// MemcacheStatus is the result of a memcache operation, see MemcacheOperationFlow.
+type MemcacheStatus uint32
+
+// Memcache status values as defined in the sFlow Memcache Structures
+const (
+	MemcacheStatusUnknown     MemcacheStatus = 0
+	MemcacheStatusOK          MemcacheStatus = 1
+	MemcacheStatusError       MemcacheStatus = 2
+	MemcacheStatusClientError MemcacheStatus = 3
+	MemcacheStatusServerError MemcacheStatus = 4
+	MemcacheStatusStored      MemcacheStatus = 5
+	MemcacheStatusNotStored   MemcacheStatus = 6
+	MemcacheStatusExists      MemcacheStatus = 7
+	MemcacheStatusNotFound    MemcacheStatus = 8
+	MemcacheStatusDeleted     MemcacheStatus = 9
+)
+
+var memcacheStatusNames = map[MemcacheStatus]string{
+	MemcacheStatusUnknown:     "UNKNOWN",
+	MemcacheStatusOK:          "OK",
+	MemcacheStatusError:       "ERROR",
+	MemcacheStatusClientError: "CLIENT_ERROR",
+	MemcacheStatusServerError: "SERVER_ERROR",
+	MemcacheStatusStored:      "STORED",
+	MemcacheStatusNotStored:   "NOT_STORED",
+	MemcacheStatusExists:      "EXISTS",
+	MemcacheStatusNotFound:    "NOT_FOUND",
+	MemcacheStatusDeleted:     "DELETED",
+}
+
+// String returns the name of the value as used in the sFlow specification.
+func (v MemcacheStatus) String() string {
+	if name, found := memcacheStatusNames[v]; found {
+		return name
+	}
+
+	return fmt.Sprintf("MemcacheStatus(%d)", uint32(v))
+}
+
+// MemcacheOperationFlow - TypeMemcacheOperationFlowRecord
+type MemcacheOperationFlow struct {
+	Protocol   MemcacheProtocol /* protocol */
+	Cmd        MemcacheCmd      /* command */
+	KeyLen     uint32
+	Key        []byte         `lengthLookUp:"KeyLen" maxLength:"255"` /* key used to store or retrieve data */
+	Nkeys      uint32         /* number of keys, including the key above */
+	ValueBytes uint32         /* size of the value in bytes */
+	Duration   uint32         /* duration of the operation in microseconds */
+	Status     MemcacheStatus /* status of the command */
+}
+
+func (f MemcacheOperationFlow) String() string {
+	type X MemcacheOperationFlow
+	x := X(f)
+	return fmt.Sprintf("MemcacheOperationFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f MemcacheOperationFlow) RecordName() string {
+	return "MemcacheOperationFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f MemcacheOperationFlow) RecordType() int {
+	return TypeMemcacheOperationFlowRecord
+}
+
+func (f MemcacheOperationFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.Protocol)
+	size += binary.Size(f.Cmd)
+	size += binary.Size(f.KeyLen)
+	size += len(f.Key) + xdrPadding(len(f.Key))
+	size += binary.Size(f.Nkeys)
+	size += binary.Size(f.ValueBytes)
+	size += binary.Size(f.Duration)
+	size += binary.Size(f.Status)
+
+	return size
+}
+
+func (f MemcacheOperationFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
@@ -0,0 +1,241 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// MIB2IPGroupCounter - TypeMIB2IPGroupCounterRecord
+type MIB2IPGroupCounter struct {
+	IPForwarding      uint32
+	IPDefaultTTL      uint32
+	IPInReceives      uint32
+	IPInHdrErrors     uint32
+	IPInAddrErrors    uint32
+	IPForwDatagrams   uint32
+	IPInUnknownProtos uint32
+	IPInDiscards      uint32
+	IPInDelivers      uint32
+	IPOutRequests     uint32
+	IPOutDiscards     uint32
+	IPOutNoRoutes     uint32
+	IPReasmTimeout    uint32
+	IPReasmReqds      uint32
+	IPReasmOKs        uint32
+	IPReasmFails      uint32
+	IPFragOKs         uint32
+	IPFragFails       uint32
+	IPFragCreates     uint32
+}
+
+func (f MIB2IPGroupCounter) String() string {
+	type X MIB2IPGroupCounter
+	x := X(f)
+	return fmt.Sprintf("MIB2IPGroupCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f MIB2IPGroupCounter) RecordName() string {
+	return "MIB2IPGroupCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f MIB2IPGroupCounter) RecordType() int {
+	return TypeMIB2IPGroupCounterRecord
+}
+
+func (f MIB2IPGroupCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f MIB2IPGroupCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// MIB2ICMPGroupCounter - TypeMIB2ICMPGroupCounterRecord
+type MIB2ICMPGroupCounter struct {
+	ICMPInMsgs           uint32
+	ICMPInErrors         uint32
+	ICMPInDestUnreachs   uint32
+	ICMPInTimeExcds      uint32
+	ICMPInParamProbs     uint32
+	ICMPInSrcQuenchs     uint32
+	ICMPInRedirects      uint32
+	ICMPInEchos          uint32
+	ICMPInEchoReps       uint32
+	ICMPInTimestamps     uint32
+	ICMPInAddrMasks      uint32
+	ICMPInAddrMaskReps   uint32
+	ICMPOutMsgs          uint32
+	ICMPOutErrors        uint32
+	ICMPOutDestUnreachs  uint32
+	ICMPOutTimeExcds     uint32
+	ICMPOutParamProbs    uint32
+	ICMPOutSrcQuenchs    uint32
+	ICMPOutRedirects     uint32
+	ICMPOutEchos         uint32
+	ICMPOutEchoReps      uint32
+	ICMPOutTimestamps    uint32
+	ICMPOutTimestampReps uint32
+	ICMPOutAddrMasks     uint32
+	ICMPOutAddrMaskReps  uint32
+}
+
+func (f MIB2ICMPGroupCounter) String() string {
+	type X MIB2ICMPGroupCounter
+	x := X(f)
+	return fmt.Sprintf("MIB2ICMPGroupCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f MIB2ICMPGroupCounter) RecordName() string {
+	return "MIB2ICMPGroupCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f MIB2ICMPGroupCounter) RecordType() int {
+	return TypeMIB2ICMPGroupCounterRecord
+}
+
+func (f MIB2ICMPGroupCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f MIB2ICMPGroupCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// MIB2TCPGroupCounter - TypeMIB2TCPGroupCounterRecord
+type MIB2TCPGroupCounter struct {
+	TCPRtoAlgorithm uint32
+	TCPRtoMin       uint32
+	TCPRtoMax       uint32
+	TCPMaxConn      int32 // -1 if the maximum is dynamic
+	TCPActiveOpens  uint32
+	TCPPassiveOpens uint32
+	TCPAttemptFails uint32
+	TCPEstabResets  uint32
+	TCPCurrEstab    uint32
+	TCPInSegs       uint32
+	TCPOutSegs      uint32
+	TCPRetransSegs  uint32
+	TCPInErrs       uint32
+	TCPOutRsts      uint32
+	TCPInCsumErrors uint32
+}
+
+func (f MIB2TCPGroupCounter) String() string {
+	type X MIB2TCPGroupCounter
+	x := X(f)
+	return fmt.Sprintf("MIB2TCPGroupCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f MIB2TCPGroupCounter) RecordName() string {
+	return "MIB2TCPGroupCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f MIB2TCPGroupCounter) RecordType() int {
+	return TypeMIB2TCPGroupCounterRecord
+}
+
+func (f MIB2TCPGroupCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f MIB2TCPGroupCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// MIB2UDPGroupCounter - TypeMIB2UDPGroupCounterRecord
+type MIB2UDPGroupCounter struct {
+	UDPInDatagrams  uint32
+	UDPNoPorts      uint32
+	UDPInErrors     uint32
+	UDPOutDatagrams uint32
+	UDPRcvbufErrors uint32
+	UDPSndbufErrors uint32
+	UDPInCsumErrors uint32
+}
+
+func (f MIB2UDPGroupCounter) String() string {
+	type X MIB2UDPGroupCounter
+	x := X(f)
+	return fmt.Sprintf("MIB2UDPGroupCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f MIB2UDPGroupCounter) RecordName() string {
+	return "MIB2UDPGroupCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f MIB2UDPGroupCounter) RecordType() int {
+	return TypeMIB2UDPGroupCounterRecord
+}
+
+func (f MIB2UDPGroupCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f MIB2UDPGroupCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
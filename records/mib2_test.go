@@ -0,0 +1,36 @@
+package records
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeDecodeMIB2CounterRecords(t *testing.T) {
+	recs := []Record{
+		MIB2IPGroupCounter{IPForwarding: 2, IPDefaultTTL: 64, IPInReceives: 1000, IPFragCreates: 4},
+		MIB2ICMPGroupCounter{ICMPInMsgs: 10, ICMPInEchos: 5, ICMPOutEchoReps: 5, ICMPOutAddrMaskReps: 1},
+		MIB2TCPGroupCounter{TCPRtoAlgorithm: 1, TCPRtoMin: 200, TCPRtoMax: 120000, TCPMaxConn: -1, TCPRetransSegs: 17, TCPInCsumErrors: 2},
+		MIB2UDPGroupCounter{UDPInDatagrams: 100, UDPInErrors: 3, UDPRcvbufErrors: 3, UDPInCsumErrors: 1},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+
+	// Sizes as given by the sFlow Host TCP/IP Counters specification
+	sizes := map[int]int{
+		TypeMIB2IPGroupCounterRecord:   19 * 4,
+		TypeMIB2ICMPGroupCounterRecord: 25 * 4,
+		TypeMIB2TCPGroupCounterRecord:  15 * 4,
+		TypeMIB2UDPGroupCounterRecord:  7 * 4,
+	}
+
+	for _, rec := range recs {
+		b := &bytes.Buffer{}
+		rec.Encode(b)
+
+		if b.Len()-8 != sizes[rec.RecordType()] {
+			t.Errorf("%s: expected %d bytes, got %d", rec.RecordName(), sizes[rec.RecordType()], b.Len()-8)
+		}
+	}
+}
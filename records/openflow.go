@@ -0,0 +1,100 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// OFPortCounter - TypeOFPortCounterRecord
+type OFPortCounter struct {
+	DatapathID uint64 /* OpenFlow datapath ID */
+	PortNo     uint32 /* OpenFlow port number */
+}
+
+func (f OFPortCounter) String() string {
+	type X OFPortCounter
+	x := X(f)
+	return fmt.Sprintf("OFPortCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f OFPortCounter) RecordName() string {
+	return "OFPortCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f OFPortCounter) RecordType() int {
+	return TypeOFPortCounterRecord
+}
+
+func (f OFPortCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f OFPortCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// PortNameCounter - TypePortNameCounterRecord
+type PortNameCounter struct {
+	NameLen uint32
+	Name    []byte `lengthLookUp:"NameLen" maxLength:"255"` /* port name */
+}
+
+func (f PortNameCounter) String() string {
+	type X PortNameCounter
+	x := X(f)
+	return fmt.Sprintf("PortNameCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f PortNameCounter) RecordName() string {
+	return "PortNameCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f PortNameCounter) RecordType() int {
+	return TypePortNameCounterRecord
+}
+
+func (f PortNameCounter) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.NameLen)
+	size += len(f.Name) + xdrPadding(len(f.Name))
+
+	return size
+}
+
+func (f PortNameCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
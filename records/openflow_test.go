@@ -0,0 +1,16 @@
+package records
+
+import (
+	"testing"
+)
+
+func TestEncodeDecodeOpenFlowCounterRecords(t *testing.T) {
+	recs := []Record{
+		OFPortCounter{DatapathID: 0x0000525400a1b2c3, PortNo: 65534},
+		PortNameCounter{NameLen: 6, Name: []byte("br-int")},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+}
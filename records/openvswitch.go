@@ -0,0 +1,55 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// OVSDPStatsCounter - TypeOVSDPStatsCounterRecord
+type OVSDPStatsCounter struct {
+	Hits     uint32 /* packets that matched an existing flow */
+	Misses   uint32 /* packets that did not match and went to userspace */
+	Lost     uint32 /* packets dropped before reaching userspace */
+	MaskHits uint32 /* masks visited to find matching flows */
+	Flows    uint32 /* number of flows in the datapath */
+	Masks    uint32 /* number of masks in the datapath */
+}
+
+func (f OVSDPStatsCounter) String() string {
+	type X OVSDPStatsCounter
+	x := X(f)
+	return fmt.Sprintf("OVSDPStatsCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f OVSDPStatsCounter) RecordName() string {
+	return "OVSDPStatsCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f OVSDPStatsCounter) RecordType() int {
+	return TypeOVSDPStatsCounterRecord
+}
+
+func (f OVSDPStatsCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f OVSDPStatsCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
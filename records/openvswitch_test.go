@@ -0,0 +1,15 @@
+package records
+
+import (
+	"testing"
+)
+
+func TestEncodeDecodeOpenVSwitchCounterRecords(t *testing.T) {
+	recs := []Record{
+		OVSDPStatsCounter{Hits: 9000, Misses: 120, Lost: 1, MaskHits: 15000, Flows: 42, Masks: 6},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+}
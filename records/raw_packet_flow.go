@@ -1,14 +1,12 @@
 package records
 
 import (
-	"bytes"
 	"encoding/binary"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"strings"
 	"io"
 	"net"
+	"strings"
 )
 
 // Header Protocol Types found in Raw Packet Flow Record
@@ -25,6 +23,12 @@ const (
 	HeaderProtocolAAL5IP            = 10
 	HeaderProtocolIPv4              = 11
 	HeaderProtocolIPv6              = 12
+	HeaderProtocolMPLS              = 13
+	HeaderProtocolPOS               = 14
+	HeaderProtocolIEEE80211MAC      = 15
+	HeaderProtocolIEEE80211AMPDU    = 16
+	HeaderProtocolIEEE80211AMSDU    = 17
+	HeaderProtocolInfiniBand        = 18
 )
 
 // Raw Packet Header Types
@@ -32,17 +36,31 @@ const (
 	HeaderTypeIPv4 = "0800"
 	HeaderTypeIPv6 = "86DD"
 
-//IPX: type_len == 0x0200 || type_len == 0x0201 || type_len == 0x0600
+// IPX: type_len == 0x0200 || type_len == 0x0201 || type_len == 0x0600
+)
+
+// EtherTypes found in Ethernet headers
+const (
+	EtherTypeIPv4       = 0x0800
+	EtherTypeARP        = 0x0806
+	EtherTypeTEB        = 0x6558 // Transparent Ethernet Bridging, e.g. in GRE and Geneve
+	EtherTypeVLAN       = 0x8100 // 802.1Q
+	EtherTypeIPv6       = 0x86DD
+	EtherTypeMPLS       = 0x8847
+	EtherTypeMPLSMulti  = 0x8848 // MPLS multicast
+	EtherTypeQinQ       = 0x88A8 // 802.1ad
+	EtherTypeLLDP       = 0x88CC
+	EtherTypeQinQLegacy = 0x9100 // pre-standard QinQ
 )
 
 // RawPacketFlow is a raw Ethernet header flow record.
 type RawPacketFlow struct {
-	Protocol      uint32
-	FrameLength   uint32
-	Stripped      uint32
-	HeaderSize    uint32
-	Header        []byte
-	DecodedHeader map[string]interface{}
+	Protocol    uint32
+	FrameLength uint32
+	Stripped    uint32
+	HeaderSize  uint32
+	Header      []byte
+	Packet      *DecodedPacket // typed layers of Header, nil for header protocols that are not decoded
 }
 
 // EthernetHeader as found in RawPacketFlow.Header
@@ -67,6 +85,81 @@ func (e *HardwareAddr) UnmarshalJSON(value []byte) error {
 	return err
 }
 
+// VLANHeader is an 802.1Q or 802.1ad tag as found in RawPacketFlow.Header
+type VLANHeader struct {
+	TPID uint16 // Tag Protocol Identifier
+	PCP  uint8  // Priority Code Point
+	DEI  bool   // Drop Eligible Indicator
+	VID  uint16 // VLAN Identifier
+}
+
+// LLCHeader is an 802.2 Logical Link Control header as found in 802.3 frames in RawPacketFlow.Header
+type LLCHeader struct {
+	DSAP    uint8
+	SSAP    uint8
+	Control uint16 // one octet for unnumbered frames, two octets otherwise
+}
+
+// SNAPHeader is a Subnetwork Access Protocol header following an LLCHeader
+type SNAPHeader struct {
+	OUI      [3]byte
+	Protocol uint16 // EtherType if OUI is 00-00-00
+}
+
+// ARPHeader as found in RawPacketFlow.Header
+type ARPHeader struct {
+	HardwareType       uint16
+	ProtocolType       uint16
+	HardwareLen        uint8
+	ProtocolLen        uint8
+	Operation          uint16
+	SenderHardwareAddr HardwareAddr
+	SenderProtocolAddr net.IP
+	TargetHardwareAddr HardwareAddr
+	TargetProtocolAddr net.IP
+}
+
+// LLDP TLV Types
+const (
+	LLDPTLVTypeEnd                = 0
+	LLDPTLVTypeChassisID          = 1
+	LLDPTLVTypePortID             = 2
+	LLDPTLVTypeTTL                = 3
+	LLDPTLVTypePortDescription    = 4
+	LLDPTLVTypeSystemName         = 5
+	LLDPTLVTypeSystemDescription  = 6
+	LLDPTLVTypeSystemCapabilities = 7
+	LLDPTLVTypeManagementAddress  = 8
+	LLDPTLVTypeOrganization       = 127
+)
+
+// LLDPTLV is a single type-length-value element of an LLDPHeader
+type LLDPTLV struct {
+	Type  uint8
+	Value []byte
+}
+
+// LLDPHeader as found in RawPacketFlow.Header
+type LLDPHeader struct {
+	ChassisIDSubtype  uint8
+	ChassisID         []byte
+	PortIDSubtype     uint8
+	PortID            []byte
+	TTL               uint16
+	PortDescription   []byte
+	SystemName        []byte
+	SystemDescription []byte
+	TLVs              []LLDPTLV // all TLVs in the order found, excluding the end TLV
+}
+
+// MPLSLabel is a label stack entry as found in RawPacketFlow.Header
+type MPLSLabel struct {
+	Label         uint32
+	TC            uint8 // Traffic Class
+	BottomOfStack bool
+	TTL           uint8
+}
+
 // IPv4Header as found in RawPacketFlow.Header
 type IPv4Header struct {
 	VersionAndLen uint8
@@ -115,6 +208,60 @@ type UDPHeader struct {
 	Checksum uint16
 }
 
+// IPv6ExtensionHeader as found in RawPacketFlow.Header between the IPv6Header and the upper-layer header
+type IPv6ExtensionHeader struct {
+	Type           uint8
+	NextHeader     uint8
+	Length         int    // total length of the extension header in octets
+	FragmentOffset uint16 // only set for fragment headers
+}
+
+// InfiniBand Link Next Header values of the IBLocalRouteHeader
+const (
+	IBLinkNextHeaderRaw    = 0 // raw packet
+	IBLinkNextHeaderIPv6   = 1 // raw IPv6 packet
+	IBLinkNextHeaderLocal  = 2 // base transport header follows
+	IBLinkNextHeaderGlobal = 3 // global route header follows
+)
+
+// IBNextHeaderBTH is the IBGlobalRouteHeader next header value of a base transport header
+const IBNextHeaderBTH = 0x1b
+
+// IBLocalRouteHeader is an InfiniBand local route header as found in RawPacketFlow.Header
+type IBLocalRouteHeader struct {
+	VL             uint8 // virtual lane
+	LinkVersion    uint8
+	SL             uint8 // service level
+	LinkNextHeader uint8
+	DLID           uint16 // destination local identifier
+	PacketLength   uint16 // in 4 byte words
+	SLID           uint16 // source local identifier
+}
+
+// IBGlobalRouteHeader is an InfiniBand global route header as found in RawPacketFlow.Header
+type IBGlobalRouteHeader struct {
+	TrafficClass  uint8
+	FlowLabel     uint32
+	PayloadLength uint16
+	NextHeader    uint8
+	HopLimit      uint8
+	SrcGID        net.IP // global identifiers share the IPv6 address format
+	DstGID        net.IP
+}
+
+// IBBaseTransportHeader is an InfiniBand base transport header as found in RawPacketFlow.Header
+type IBBaseTransportHeader struct {
+	Opcode           uint8
+	SolicitedEvent   bool
+	MigrationRequest bool
+	PadCount         uint8
+	TransportVersion uint8
+	PKey             uint16 // partition key
+	DestQP           uint32 // destination queue pair
+	AckRequest       bool
+	PSN              uint32 // packet sequence number
+}
+
 // ICMPHeader as found in RawPacketFlow.Header
 type ICMPHeader struct {
 	Type uint8
@@ -137,119 +284,24 @@ func (f RawPacketFlow) RecordName() string {
 	return "RawPacketFlow"
 }
 
-func (f *RawPacketFlow) decodeIPHeader(ipVersion int, h io.Reader) error {
-	var err error
-
-	if ipVersion == 4 {
-		ip := IPv4Header{}
-
-		_, err = decodeInto(h, &ip)
-		f.DecodedHeader["ip"] = ip
-
-		if err != nil {
-			return err
-		}
-
-		//Can we decode a following Layer4 Protocol Header?
-		// See https://en.wikipedia.org/wiki/List_of_IP_protocol_numbers
-		switch ip.Protocol {
-		case IPProtocolESP, IPProtocolAH:
-			// No use in decoding ipsec headers
-			break
-		case IPProtocolTCP:
-			tcp := TCPHeader{}
-			_, err = decodeInto(h, &tcp)
-			f.DecodedHeader["tcp"] = tcp
-
-			if err != nil {
-				return err
-			}
-		case IPProtocolUDP:
-			udp := UDPHeader{}
-			_, err = decodeInto(h, &udp)
-			f.DecodedHeader["udp"] = udp
-			if err != nil {
-				return err
-			}
-		case IPProtocolICMP:
-			icmp := ICMPHeader{}
-			_, err = decodeInto(h, &icmp)
-			f.DecodedHeader["icmp"] = icmp
-			if err != nil {
-				return err
-			}
-		default:
-			fmt.Printf("Unknown Protocol: %d\n", ip.Protocol)
-		}
-
-	} else if ipVersion == 6 {
-		//FIXME: IPv6 has complex Header Extensions
-		//FIXME: IMPLEMENT ME
-		return fmt.Errorf("IPv6 is not implemented yet")
-
-		/*IPHeader := IPv6Header{}
-
-		if err = Decode(h, &IPHeader); err != nil {
-			return err
-		}
-		f.DecodedHeader["ip"] = IPHeader*/
+// HeaderMap returns the decoded layers of Header keyed by "ethernet", "ip", "tcp", ...
+// The map is built on every call, Packet gives access to the layers without allocating.
+func (f RawPacketFlow) HeaderMap() map[string]interface{} {
+	if f.Packet == nil {
+		return make(map[string]interface{})
 	}
 
-	return nil
+	return f.Packet.headerMap()
 }
 
 func (f *RawPacketFlow) decodeHeader(headerType uint32) error {
-	var err error
-
-	f.DecodedHeader = make(map[string]interface{})
-
-	if len(f.Header) < MinimumEthernetHeaderSize {
-		return nil
-	}
-
-	h := bytes.NewReader(f.Header)
-
+	// Short headers are decoded as far as they go, DecodePacket marks them as truncated
 	switch headerType {
-	case HeaderProtocolEthernetISO8023:
-		ethernet := EthernetHeader{}
-		_, err = decodeInto(h, &ethernet)
-		f.DecodedHeader["ethernet"] = ethernet
-		if err != nil {
-			return err
-		}
-
-		// Determine the Type of the next Header
-		buffer := make([]byte, 2)
-		if err = binary.Read(h, binary.BigEndian, &buffer); err != nil {
-			return err
-		}
-
-		//TODO: Handle VSNAP / 802.2/802 &  IPX
-
-		switch hex.EncodeToString(buffer) {
-		case HeaderTypeIPv4:
-			if err = f.decodeIPHeader(4, h); err != nil {
-				return err
-			}
-		case HeaderTypeIPv6:
-			if err = f.decodeIPHeader(6, h); err != nil {
-				return err
-			}
-		}
-	case HeaderProtocolIPv4:
-		if err = f.decodeIPHeader(4, h); err != nil {
-			return err
-		}
-	case HeaderProtocolIPv6:
-		if err = f.decodeIPHeader(6, h); err != nil {
-			return err
-		}
-	default:
-		fmt.Printf("Unknown Headertype: %d\n", headerType)
+	case HeaderProtocolEthernetISO8023, HeaderProtocolIPv4, HeaderProtocolIPv6, HeaderProtocolMPLS, HeaderProtocolInfiniBand:
+		f.Packet = DecodePacket(headerType, f.Header)
 	}
 
-	//fmt.Printf("Headers: %+#v\n", f.DecodedHeader)
-	return err
+	return nil
 }
 
 // DecodeRawPacketFlow decodes an TypeRawPacketFlowRecord
@@ -0,0 +1,537 @@
+package records
+
+import (
+	"net"
+	"testing"
+)
+
+var testIPv6Header = []byte{
+	0x60, 0x00, 0x00, 0x00, // version, traffic class, flow label
+	0x00, 0x28, 0x00, 0x40, // payload length, next header (hop-by-hop), hop limit
+	0x20, 0x01, 0x0d, 0xb8, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, // 2001:db8::1
+	0x20, 0x01, 0x0d, 0xb8, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, // 2001:db8::2
+}
+
+func TestDecodeRawPacketFlowIPv6ExtensionHeaders(t *testing.T) {
+	header := []byte{
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x01, // dst mac
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x02, // src mac
+		0x86, 0xdd, // IPv6
+	}
+	header = append(header, testIPv6Header...)
+	header = append(header,
+		// hop-by-hop options, next header fragment, 8 octets
+		0x2c, 0x00, 0x01, 0x04, 0x00, 0x00, 0x00, 0x00,
+		// fragment, next header TCP, offset 0, more fragments
+		0x06, 0x00, 0x00, 0x01, 0x12, 0x34, 0x56, 0x78,
+		// TCP
+		0xc0, 0x01, 0x00, 0x50, // ports 49153 -> 80
+		0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00,
+		0x50, 0x02, 0xff, 0xff, 0x00, 0x00, 0x00, 0x00,
+	)
+
+	f := RawPacketFlow{Header: header}
+	if err := f.decodeHeader(HeaderProtocolEthernetISO8023); err != nil {
+		t.Fatal(err)
+	}
+
+	ip, ok := f.HeaderMap()["ip"].(IPv6Header)
+	if !ok {
+		t.Fatalf("expected an IPv6Header, got %T", f.HeaderMap()["ip"])
+	}
+
+	if !ip.DstAddr.Equal(net.ParseIP("2001:db8::2")) {
+		t.Errorf("expected DstAddr to be 2001:db8::2, got %s", ip.DstAddr)
+	}
+
+	extensionHeaders, ok := f.HeaderMap()["ipv6ExtensionHeaders"].([]IPv6ExtensionHeader)
+	if !ok {
+		t.Fatalf("expected IPv6 extension headers, got %T", f.HeaderMap()["ipv6ExtensionHeaders"])
+	}
+
+	if len(extensionHeaders) != 2 {
+		t.Fatalf("expected 2 extension headers, got %d", len(extensionHeaders))
+	}
+
+	if extensionHeaders[1].Type != IPProtocolFragment {
+		t.Errorf("expected a fragment header, got %d", extensionHeaders[1].Type)
+	}
+
+	tcp, ok := f.HeaderMap()["tcp"].(TCPHeader)
+	if !ok {
+		t.Fatalf("expected a TCPHeader, got %T", f.HeaderMap()["tcp"])
+	}
+
+	if tcp.SrcPort != 49153 || tcp.DstPort != 80 {
+		t.Errorf("expected ports 49153 -> 80, got %d -> %d", tcp.SrcPort, tcp.DstPort)
+	}
+}
+
+func TestDecodeRawPacketFlowICMPv6(t *testing.T) {
+	header := append([]byte{}, testIPv6Header...)
+	header[6] = IPProtocolICMPv6
+	header = append(header, 0x80, 0x00, 0x00, 0x00) // echo request
+
+	f := RawPacketFlow{Header: header}
+	if err := f.decodeHeader(HeaderProtocolIPv6); err != nil {
+		t.Fatal(err)
+	}
+
+	icmp, ok := f.HeaderMap()["icmpv6"].(ICMPHeader)
+	if !ok {
+		t.Fatalf("expected an ICMPHeader, got %T", f.HeaderMap()["icmpv6"])
+	}
+
+	if icmp.Type != 128 {
+		t.Errorf("expected ICMPv6 type 128, got %d", icmp.Type)
+	}
+}
+
+func TestDecodeRawPacketFlowQinQ(t *testing.T) {
+	header := []byte{
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x01, // dst mac
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x02, // src mac
+		0x88, 0xa8, 0xa0, 0x64, // 802.1ad, PCP 5, VID 100
+		0x81, 0x00, 0x10, 0xc8, // 802.1Q, DEI, VID 200
+		0x08, 0x00, // IPv4
+		0x45, 0x00, 0x00, 0x1c, 0x00, 0x00, 0x40, 0x00,
+		0x40, 0x11, 0x00, 0x00, 0xc0, 0x00, 0x02, 0x01,
+		0xc0, 0x00, 0x02, 0x02, // 192.0.2.1 -> 192.0.2.2
+		0x00, 0x35, 0x00, 0x35, 0x00, 0x08, 0x00, 0x00, // UDP 53 -> 53
+	}
+
+	f := RawPacketFlow{Header: header}
+	if err := f.decodeHeader(HeaderProtocolEthernetISO8023); err != nil {
+		t.Fatal(err)
+	}
+
+	vlans, ok := f.HeaderMap()["vlan"].([]VLANHeader)
+	if !ok {
+		t.Fatalf("expected VLAN headers, got %T", f.HeaderMap()["vlan"])
+	}
+
+	expected := []VLANHeader{
+		{TPID: EtherTypeQinQ, PCP: 5, DEI: false, VID: 100},
+		{TPID: EtherTypeVLAN, PCP: 0, DEI: true, VID: 200},
+	}
+
+	if len(vlans) != len(expected) {
+		t.Fatalf("expected %d VLAN headers, got %d", len(expected), len(vlans))
+	}
+
+	for i := range expected {
+		if vlans[i] != expected[i] {
+			t.Errorf("expected\n%+#v\n, got\n%+#v", expected[i], vlans[i])
+		}
+	}
+
+	udp, ok := f.HeaderMap()["udp"].(UDPHeader)
+	if !ok {
+		t.Fatalf("expected a UDPHeader, got %T", f.HeaderMap()["udp"])
+	}
+
+	if udp.DstPort != 53 {
+		t.Errorf("expected DstPort to be 53, got %d", udp.DstPort)
+	}
+}
+
+func TestDecodePacketTCPOptionsAndTruncation(t *testing.T) {
+	header := []byte{
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x01, // dst mac
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x02, // src mac
+		0x08, 0x00, // IPv4
+		0x46, 0x00, 0x00, 0x3c, 0x00, 0x00, 0x40, 0x00,
+		0x40, 0x06, 0x00, 0x00, 0xc0, 0x00, 0x02, 0x01,
+		0xc0, 0x00, 0x02, 0x02, // 192.0.2.1 -> 192.0.2.2
+		0x01, 0x01, 0x01, 0x01, // IP options
+		0x01, 0xbb, 0xc0, 0x01, // ports 443 -> 49153
+		0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00,
+		0x60, 0x18, 0xff, 0xff, 0x00, 0x00, 0x00, 0x00,
+		0x01, 0x01, 0x01, 0x01, // TCP options
+		0x16, 0x03, // payload
+	}
+
+	p := DecodePacket(HeaderProtocolEthernetISO8023, header)
+
+	tuple, ok := p.FiveTuple()
+	if !ok {
+		t.Fatal("expected a five tuple")
+	}
+
+	expected := FiveTuple{
+		SrcAddr:  net.IP{192, 0, 2, 1},
+		DstAddr:  net.IP{192, 0, 2, 2},
+		Protocol: IPProtocolTCP,
+		SrcPort:  443,
+		DstPort:  49153,
+	}
+
+	if !tuple.SrcAddr.Equal(expected.SrcAddr) || !tuple.DstAddr.Equal(expected.DstAddr) ||
+		tuple.Protocol != expected.Protocol || tuple.SrcPort != expected.SrcPort || tuple.DstPort != expected.DstPort {
+		t.Errorf("expected\n%+#v\n, got\n%+#v", expected, tuple)
+	}
+
+	if p.PayloadOffset() != len(header)-2 {
+		t.Errorf("expected PayloadOffset to be %d, got %d", len(header)-2, p.PayloadOffset())
+	}
+
+	if p.Truncated() {
+		t.Error("expected packet not to be truncated")
+	}
+
+	// Cut the header in the middle of the TCP header
+	p = DecodePacket(HeaderProtocolEthernetISO8023, header[:50])
+
+	if !p.Truncated() {
+		t.Error("expected packet to be truncated")
+	}
+
+	if p.IPv4 == nil || p.TCP != nil {
+		t.Errorf("expected an IPv4 layer and no TCP layer, got %+v and %+v", p.IPv4, p.TCP)
+	}
+
+	if p.PayloadOffset() != 38 {
+		t.Errorf("expected PayloadOffset to be %d, got %d", 38, p.PayloadOffset())
+	}
+}
+
+// testIPv4UDPPacket returns an IPv4 header followed by a UDP header and payload.
+func testIPv4UDPPacket(src, dst byte, dstPort uint16, payload []byte) []byte {
+	b := []byte{
+		0x45, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x00,
+		0x40, 0x11, 0x00, 0x00, 0xc0, 0x00, 0x02, src,
+		0xc0, 0x00, 0x02, dst,
+		0xc0, 0x01, byte(dstPort >> 8), byte(dstPort), 0x00, 0x00, 0x00, 0x00,
+	}
+	return append(b, payload...)
+}
+
+func TestDecodePacketVXLAN(t *testing.T) {
+	inner := []byte{
+		0x02, 0x00, 0x00, 0x00, 0x00, 0x01, // dst mac
+		0x02, 0x00, 0x00, 0x00, 0x00, 0x02, // src mac
+		0x08, 0x00, // IPv4
+	}
+	inner = append(inner, testIPv4UDPPacket(10, 20, 53, nil)...)
+
+	vxlan := []byte{0x08, 0x00, 0x00, 0x00, 0x00, 0x13, 0x88, 0x00} // VNI 5000
+	header := testIPv4UDPPacket(1, 2, UDPPortVXLAN, append(vxlan, inner...))
+
+	p := DecodePacket(HeaderProtocolIPv4, header)
+
+	if p.Tunnel == nil {
+		t.Fatal("expected a tunnel header")
+	}
+
+	expected := TunnelHeader{Type: TunnelTypeVXLAN, Protocol: EtherTypeTEB, VNI: 5000, VNIPresent: true}
+	if *p.Tunnel != expected {
+		t.Errorf("expected\n%+#v\n, got\n%+#v", expected, *p.Tunnel)
+	}
+
+	if p.Inner == nil || p.Inner.Ethernet == nil {
+		t.Fatal("expected an inner Ethernet layer")
+	}
+
+	tuple, ok := p.Innermost().FiveTuple()
+	if !ok {
+		t.Fatal("expected an inner five tuple")
+	}
+
+	if !tuple.SrcAddr.Equal(net.IP{192, 0, 2, 10}) || tuple.DstPort != 53 {
+		t.Errorf("expected inner 192.0.2.10 -> :53, got %s -> :%d", tuple.SrcAddr, tuple.DstPort)
+	}
+}
+
+func TestDecodePacketNVGRE(t *testing.T) {
+	header := []byte{
+		0x45, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x00,
+		0x40, 0x2f, 0x00, 0x00, 0xc0, 0x00, 0x02, 0x01,
+		0xc0, 0x00, 0x02, 0x02,
+		0x20, 0x00, 0x65, 0x58, // key present, transparent Ethernet bridging
+		0x00, 0x10, 0x00, 0x01, // VSID 4096, flow id 1
+		0x02, 0x00, 0x00, 0x00, 0x00, 0x01, // dst mac
+		0x02, 0x00, 0x00, 0x00, 0x00, 0x02, // src mac
+		0x86, 0xdd, // IPv6
+	}
+	header = append(header, testIPv6Header...)
+	header[len(header)-40+6] = IPProtocolTCP
+
+	p := DecodePacket(HeaderProtocolIPv4, header)
+
+	if p.Tunnel == nil || p.Tunnel.Type != TunnelTypeGRE {
+		t.Fatalf("expected a GRE tunnel header, got %+v", p.Tunnel)
+	}
+
+	if p.Tunnel.VNI>>8 != 4096 {
+		t.Errorf("expected VSID 4096, got %d", p.Tunnel.VNI>>8)
+	}
+
+	if p.Inner == nil || p.Inner.IPv6 == nil {
+		t.Fatal("expected an inner IPv6 layer")
+	}
+
+	if !p.Inner.Truncated() {
+		t.Error("expected the inner packet to be truncated")
+	}
+}
+
+func TestDecodePacketIPinIP(t *testing.T) {
+	header := []byte{
+		0x45, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x00,
+		0x40, 0x04, 0x00, 0x00, 0xc0, 0x00, 0x02, 0x01,
+		0xc0, 0x00, 0x02, 0x02,
+	}
+	header = append(header, testIPv4UDPPacket(3, 4, 123, nil)...)
+
+	p := DecodePacket(HeaderProtocolIPv4, header)
+
+	if p.Tunnel == nil || p.Tunnel.Type != TunnelTypeIPinIP || p.Tunnel.VNIPresent {
+		t.Fatalf("expected an IP-in-IP tunnel header, got %+v", p.Tunnel)
+	}
+
+	if p.Inner == nil || p.Inner.UDP == nil || p.Inner.UDP.DstPort != 123 {
+		t.Fatalf("expected an inner UDP layer, got %+v", p.Inner)
+	}
+}
+
+func TestDecodePacketMPLS(t *testing.T) {
+	header := []byte{
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x01, // dst mac
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x02, // src mac
+		0x88, 0x47, // MPLS
+		0x00, 0x3e, 0x8a, 0x40, // label 1000, TC 5, TTL 64
+		0x00, 0x00, 0x31, 0x3f, // label 3, bottom of stack, TTL 63
+	}
+	header = append(header, testIPv4UDPPacket(1, 2, 4739, nil)...)
+
+	f := RawPacketFlow{Header: header}
+	if err := f.decodeHeader(HeaderProtocolEthernetISO8023); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []MPLSLabel{
+		{Label: 1000, TC: 5, BottomOfStack: false, TTL: 64},
+		{Label: 3, TC: 0, BottomOfStack: true, TTL: 63},
+	}
+
+	if len(f.Packet.MPLSLabels) != len(expected) {
+		t.Fatalf("expected %d labels, got %d", len(expected), len(f.Packet.MPLSLabels))
+	}
+
+	for i := range expected {
+		if f.Packet.MPLSLabels[i] != expected[i] {
+			t.Errorf("expected\n%+#v\n, got\n%+#v", expected[i], f.Packet.MPLSLabels[i])
+		}
+	}
+
+	if _, ok := f.HeaderMap()["mpls"].([]MPLSLabel); !ok {
+		t.Errorf("expected MPLS labels, got %T", f.HeaderMap()["mpls"])
+	}
+
+	if f.Packet.UDP == nil || f.Packet.UDP.DstPort != 4739 {
+		t.Errorf("expected a UDP layer behind the label stack, got %+v", f.Packet.UDP)
+	}
+}
+
+func TestDecodePacketARP(t *testing.T) {
+	header := []byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, // dst mac
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x02, // src mac
+		0x08, 0x06, // ARP
+		0x00, 0x01, 0x08, 0x00, // hardware type ethernet, protocol type IPv4
+		0x06, 0x04, 0x00, 0x01, // hardware length, protocol length, request
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x02, 192, 0, 2, 2, // sender
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 192, 0, 2, 1, // target
+	}
+
+	p := DecodePacket(HeaderProtocolEthernetISO8023, header)
+
+	if p.ARP == nil {
+		t.Fatal("expected an ARP layer")
+	}
+
+	if p.ARP.Operation != 1 || p.ARP.ProtocolType != EtherTypeIPv4 {
+		t.Errorf("unexpected ARP header %+v", p.ARP)
+	}
+
+	if net.HardwareAddr(p.ARP.SenderHardwareAddr).String() != "00:00:5e:00:53:02" {
+		t.Errorf("expected sender 00:00:5e:00:53:02, got %s", net.HardwareAddr(p.ARP.SenderHardwareAddr))
+	}
+
+	if !p.ARP.TargetProtocolAddr.Equal(net.IPv4(192, 0, 2, 1)) {
+		t.Errorf("expected target 192.0.2.1, got %s", p.ARP.TargetProtocolAddr)
+	}
+
+	if p.PayloadOffset() != len(header) || p.Truncated() {
+		t.Errorf("expected payload offset %d, got %d (truncated %v)", len(header), p.PayloadOffset(), p.Truncated())
+	}
+}
+
+func TestDecodePacketLLDP(t *testing.T) {
+	header := []byte{
+		0x01, 0x80, 0xc2, 0x00, 0x00, 0x0e, // dst mac
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x02, // src mac
+		0x88, 0xcc, // LLDP
+		0x02, 0x07, 0x04, 0x00, 0x00, 0x5e, 0x00, 0x53, 0x02, // chassis id, mac address
+		0x04, 0x04, 0x05, 'e', 't', '0', // port id, interface name
+		0x06, 0x02, 0x00, 0x78, // ttl 120
+		0x0a, 0x03, 's', 'w', '1', // system name
+		0x00, 0x00, // end
+	}
+
+	f := RawPacketFlow{Header: header}
+	if err := f.decodeHeader(HeaderProtocolEthernetISO8023); err != nil {
+		t.Fatal(err)
+	}
+
+	lldp, ok := f.HeaderMap()["lldp"].(LLDPHeader)
+	if !ok {
+		t.Fatalf("expected an LLDP header, got %T", f.HeaderMap()["lldp"])
+	}
+
+	if lldp.ChassisIDSubtype != 4 || net.HardwareAddr(lldp.ChassisID).String() != "00:00:5e:00:53:02" {
+		t.Errorf("unexpected chassis id %d %x", lldp.ChassisIDSubtype, lldp.ChassisID)
+	}
+
+	if lldp.PortIDSubtype != 5 || string(lldp.PortID) != "et0" {
+		t.Errorf("unexpected port id %d %q", lldp.PortIDSubtype, lldp.PortID)
+	}
+
+	if lldp.TTL != 120 || string(lldp.SystemName) != "sw1" {
+		t.Errorf("unexpected ttl %d or system name %q", lldp.TTL, lldp.SystemName)
+	}
+
+	if len(lldp.TLVs) != 4 {
+		t.Errorf("expected 4 TLVs, got %d", len(lldp.TLVs))
+	}
+}
+
+func TestDecodePacketLLCSNAP(t *testing.T) {
+	ip := testIPv4UDPPacket(1, 2, 53, nil)
+
+	header := []byte{
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x01, // dst mac
+		0x00, 0x00, 0x5e, 0x00, 0x53, 0x02, // src mac
+		0x00, byte(8 + len(ip)), // 802.3 length
+		0xaa, 0xaa, 0x03, // LLC, unnumbered information
+		0x00, 0x00, 0x00, 0x08, 0x00, // SNAP, IPv4
+	}
+	header = append(header, ip...)
+
+	p := DecodePacket(HeaderProtocolEthernetISO8023, header)
+
+	if p.LLC == nil || *p.LLC != (LLCHeader{DSAP: 0xaa, SSAP: 0xaa, Control: 0x03}) {
+		t.Fatalf("unexpected LLC header %+v", p.LLC)
+	}
+
+	if p.SNAP == nil || p.SNAP.Protocol != EtherTypeIPv4 {
+		t.Fatalf("unexpected SNAP header %+v", p.SNAP)
+	}
+
+	if p.EtherType != EtherTypeIPv4 || p.UDP == nil || p.UDP.DstPort != 53 {
+		t.Errorf("expected an IPv4 UDP packet behind SNAP, got ethertype %#x udp %+v", p.EtherType, p.UDP)
+	}
+
+	// Spanning tree BPDUs use a plain LLC header
+	stp := append(append([]byte{}, header[:12]...), 0x00, 0x26, 0x42, 0x42, 0x03, 0x00, 0x00)
+	p = DecodePacket(HeaderProtocolEthernetISO8023, stp)
+
+	if p.LLC == nil || p.LLC.DSAP != 0x42 || p.SNAP != nil || p.EtherType != 0 {
+		t.Errorf("unexpected STP decoding %+v %+v %#x", p.LLC, p.SNAP, p.EtherType)
+	}
+
+	if p.PayloadOffset() != 17 {
+		t.Errorf("expected payload offset 17, got %d", p.PayloadOffset())
+	}
+}
+
+func TestDecodeRawPacketFlowInfiniBand(t *testing.T) {
+	header := []byte{
+		0x03, 0x33, 0x00, 0x12, // VL 0, LVer 3, SL 3, LNH global, DLID 0x12
+		0x00, 0x1b, 0x00, 0x34, // packet length 27 words, SLID 0x34
+		0x60, 0x40, 0x00, 0x01, // IPVer 6, TClass 4, flow label 1
+		0x00, 0x4c, 0x1b, 0x01, // payload length, next header BTH, hop limit
+		0xfe, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, // source GID
+		0xfe, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, // destination GID
+		0x04, 0x80, 0xff, 0xff, // opcode RC send only, solicited event, pkey
+		0x00, 0x00, 0x01, 0x02, // destination QP
+		0x80, 0x00, 0x00, 0x07, // ack request, PSN 7
+	}
+
+	f := RawPacketFlow{Header: header}
+	if err := f.decodeHeader(HeaderProtocolInfiniBand); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedLRH := IBLocalRouteHeader{LinkVersion: 3, SL: 3, LinkNextHeader: IBLinkNextHeaderGlobal, DLID: 0x12, PacketLength: 27, SLID: 0x34}
+	if f.Packet.IBLRH == nil || *f.Packet.IBLRH != expectedLRH {
+		t.Errorf("expected\n%+#v\n, got\n%+#v", expectedLRH, f.Packet.IBLRH)
+	}
+
+	if f.Packet.IBGRH == nil || f.Packet.IBGRH.TrafficClass != 4 || f.Packet.IBGRH.FlowLabel != 1 ||
+		!f.Packet.IBGRH.DstGID.Equal(net.ParseIP("fe80::2")) {
+		t.Errorf("unexpected global route header %+v", f.Packet.IBGRH)
+	}
+
+	expectedBTH := IBBaseTransportHeader{Opcode: 0x04, SolicitedEvent: true, PKey: 0xffff, DestQP: 0x102, AckRequest: true, PSN: 7}
+	if f.Packet.IBBTH == nil || *f.Packet.IBBTH != expectedBTH {
+		t.Errorf("expected\n%+#v\n, got\n%+#v", expectedBTH, f.Packet.IBBTH)
+	}
+
+	if _, ok := f.HeaderMap()["ib_bth"]; !ok {
+		t.Errorf("expected an ib_bth layer in %v", f.HeaderMap())
+	}
+
+	if f.Packet.PayloadOffset() != len(header) {
+		t.Errorf("expected payload offset %d, got %d", len(header), f.Packet.PayloadOffset())
+	}
+}
+
+func TestDecodeRawPacketFlowShortHeaders(t *testing.T) {
+	// Headers shorter than an Ethernet header are valid for other header protocols
+	ib := RawPacketFlow{Header: []byte{
+		0x03, 0x30, 0x00, 0x12, // VL 0, LVer 3, SL 3, LNH raw, DLID 0x12
+		0x00, 0x02, 0x00, 0x34, // packet length 2 words, SLID 0x34
+	}}
+	if err := ib.decodeHeader(HeaderProtocolInfiniBand); err != nil {
+		t.Fatal(err)
+	}
+
+	if ib.Packet == nil || ib.Packet.IBLRH == nil || ib.Packet.IBLRH.DLID != 0x12 {
+		t.Errorf("expected a local route header, got %+v", ib.Packet)
+	}
+
+	mpls := RawPacketFlow{Header: []byte{
+		0x00, 0x3e, 0x8b, 0x40, // label 1000, TC 5, bottom of stack, TTL 64
+	}}
+	if err := mpls.decodeHeader(HeaderProtocolMPLS); err != nil {
+		t.Fatal(err)
+	}
+
+	if mpls.Packet == nil || len(mpls.Packet.MPLSLabels) != 1 || mpls.Packet.MPLSLabels[0].Label != 1000 {
+		t.Errorf("expected a single MPLS label, got %+v", mpls.Packet)
+	}
+
+	ipv4 := RawPacketFlow{Header: testIPv4UDPPacket(1, 2, 53, nil)[:12]}
+	if err := ipv4.decodeHeader(HeaderProtocolIPv4); err != nil {
+		t.Fatal(err)
+	}
+
+	if ipv4.Packet == nil || !ipv4.Packet.Truncated() || ipv4.Packet.IPv4 != nil {
+		t.Errorf("expected a truncated packet without layers, got %+v", ipv4.Packet)
+	}
+
+	// Header protocols without a decoder are skipped quietly
+	unknown := RawPacketFlow{Header: []byte{0x01, 0x02, 0x03, 0x04}}
+	if err := unknown.decodeHeader(HeaderProtocolFDDI); err != nil {
+		t.Fatal(err)
+	}
+
+	if unknown.Packet != nil {
+		t.Errorf("expected no decoded packet, got %+v", unknown.Packet)
+	}
+}
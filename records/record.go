@@ -1,6 +1,7 @@
 package records
 
 import (
+	"encoding/json"
 	"errors"
 	"io"
 )
@@ -15,3 +16,21 @@ type Record interface {
 	RecordName() string
 	Encode(w io.Writer) error
 }
+
+// XDRString alias of []byte holding XDR string data to be able to add JSON Marshalling
+type XDRString []byte
+
+// MarshalJSON creates a JSON string of an XDRString
+func (s XDRString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(s))
+}
+
+// UnmarshalJSON reads a JSON string into an XDRString
+func (s *XDRString) UnmarshalJSON(value []byte) error {
+	var x string
+	if err := json.Unmarshal(value, &x); err != nil {
+		return err
+	}
+	*s = XDRString(x)
+	return nil
+}
@@ -0,0 +1,110 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+)
+
+// SampledIPv4Flow is a sampled_ipv4 flow record describing the IPv4 header of a sampled packet.
+type SampledIPv4Flow struct {
+	Length   uint32 // The length of the IP packet excluding lower layer encapsulations
+	Protocol uint32 // IP Protocol type (for example, TCP = 6, UDP = 17)
+	SrcIP    net.IP `ipVersion:"4"`
+	DstIP    net.IP `ipVersion:"4"`
+	SrcPort  uint32 // TCP/UDP source port number or equivalent
+	DstPort  uint32 // TCP/UDP destination port number or equivalent
+	TCPFlags uint32 // TCP flags
+	TOS      uint32 // IP type of service
+}
+
+func (f SampledIPv4Flow) String() string {
+	type X SampledIPv4Flow
+	x := X(f)
+	return fmt.Sprintf("SampledIPv4Flow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f SampledIPv4Flow) RecordName() string {
+	return "SampledIPv4Flow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f SampledIPv4Flow) RecordType() int {
+	return TypeIpv4FlowRecord
+}
+
+func (f SampledIPv4Flow) calculateBinarySize() int {
+	// Six 32 bit fields and two IPv4 addresses
+	return 6*4 + 2*net.IPv4len
+}
+
+func (f SampledIPv4Flow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// SampledIPv6Flow is a sampled_ipv6 flow record describing the IPv6 header of a sampled packet.
+type SampledIPv6Flow struct {
+	Length   uint32 // The length of the IP packet excluding lower layer encapsulations
+	Protocol uint32 // IP next header (for example, TCP = 6, UDP = 17)
+	SrcIP    net.IP `ipVersion:"6"`
+	DstIP    net.IP `ipVersion:"6"`
+	SrcPort  uint32 // TCP/UDP source port number or equivalent
+	DstPort  uint32 // TCP/UDP destination port number or equivalent
+	TCPFlags uint32 // TCP flags
+	Priority uint32 // IP priority
+}
+
+func (f SampledIPv6Flow) String() string {
+	type X SampledIPv6Flow
+	x := X(f)
+	return fmt.Sprintf("SampledIPv6Flow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f SampledIPv6Flow) RecordName() string {
+	return "SampledIPv6Flow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f SampledIPv6Flow) RecordType() int {
+	return TypeIpv6FlowRecord
+}
+
+func (f SampledIPv6Flow) calculateBinarySize() int {
+	// Six 32 bit fields and two IPv6 addresses
+	return 6*4 + 2*net.IPv6len
+}
+
+func (f SampledIPv6Flow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
@@ -0,0 +1,50 @@
+package records
+
+import (
+	"bytes"
+	"net"
+	"testing"
+)
+
+func TestEncodeDecodeSampledIPv4FlowRecord(t *testing.T) {
+	rec := SampledIPv4Flow{
+		Length:   1500,
+		Protocol: IPProtocolTCP,
+		SrcIP:    net.IP{192, 0, 2, 1},
+		DstIP:    net.IP{198, 51, 100, 2},
+		SrcPort:  49152,
+		DstPort:  443,
+		TCPFlags: 0x18,
+		TOS:      0x10,
+	}
+
+	roundTrip(t, rec)
+}
+
+func TestEncodeDecodeSampledIPv6FlowRecord(t *testing.T) {
+	rec := SampledIPv6Flow{
+		Length:   1280,
+		Protocol: IPProtocolUDP,
+		SrcIP:    net.ParseIP("2001:db8::1"),
+		DstIP:    net.ParseIP("2001:db8::2"),
+		SrcPort:  53,
+		DstPort:  33000,
+		Priority: 5,
+	}
+
+	roundTrip(t, rec)
+}
+
+func TestEncodeSampledIPFlowInvalidAddress(t *testing.T) {
+	recs := []Record{
+		SampledIPv4Flow{},
+		SampledIPv4Flow{SrcIP: net.ParseIP("2001:db8::1"), DstIP: net.IP{192, 0, 2, 1}},
+		SampledIPv6Flow{},
+	}
+
+	for _, rec := range recs {
+		if err := rec.Encode(&bytes.Buffer{}); err == nil {
+			t.Errorf("expected an error encoding %+v", rec)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// TransactionDirection tells whether a host acted as client or server of a transaction.
+type TransactionDirection uint32
+
+// Transaction directions as defined in the Host performance statistics
+const (
+	TransactionDirectionClient TransactionDirection = 1
+	TransactionDirectionServer TransactionDirection = 2
+)
+
+var transactionDirectionNames = map[TransactionDirection]string{
+	TransactionDirectionClient: "client",
+	TransactionDirectionServer: "server",
+}
+
+// String returns the name of the value as used in the sFlow specification.
+func (v TransactionDirection) String() string {
+	if name, found := transactionDirectionNames[v]; found {
+		return name
+	}
+
+	return fmt.Sprintf("TransactionDirection(%d)", uint32(v))
+}
+
+// TransactionStatus is the result of a transaction, see TransactionFlow.
+type TransactionStatus uint32
+
+// Transaction status values as defined in the Host performance statistics
+const (
+	TransactionStatusSucceeded      TransactionStatus = 0
+	TransactionStatusGenericFailure TransactionStatus = 1
+	TransactionStatusOutOfMemory    TransactionStatus = 2
+	TransactionStatusTimeout        TransactionStatus = 3
+	TransactionStatusNotPermitted   TransactionStatus = 4
+)
+
+var transactionStatusNames = map[TransactionStatus]string{
+	TransactionStatusSucceeded:      "succeeded",
+	TransactionStatusGenericFailure: "generic_failure",
+	TransactionStatusOutOfMemory:    "outofmemory",
+	TransactionStatusTimeout:        "timeout",
+	TransactionStatusNotPermitted:   "notpermitted",
+}
+
+// String returns the name of the value as used in the sFlow specification.
+func (v TransactionStatus) String() string {
+	if name, found := transactionStatusNames[v]; found {
+		return name
+	}
+
+	return fmt.Sprintf("TransactionStatus(%d)", uint32(v))
+}
+
+// TransactionFlow - TypeTransactionFlowRecord
+type TransactionFlow struct {
+	Direction     TransactionDirection /* client or server */
+	Wait          uint32               /* time in microseconds waiting to start */
+	Duration      uint32               /* duration in microseconds */
+	Status        TransactionStatus    /* status of the transaction */
+	BytesReceived uint64               /* bytes received */
+	BytesSend     uint64               /* bytes sent */
+}
+
+func (f TransactionFlow) String() string {
+	type X TransactionFlow
+	x := X(f)
+	return fmt.Sprintf("TransactionFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f TransactionFlow) RecordName() string {
+	return "TransactionFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f TransactionFlow) RecordType() int {
+	return TypeTransactionFlowRecord
+}
+
+func (f TransactionFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f TransactionFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// ExtendedNFSStorageTransactionFlow - TypeExtendedNFSStorageTransactionFlowRecord
+type ExtendedNFSStorageTransactionFlow struct {
+	PathLen   uint32
+	Path      []byte `lengthLookUp:"PathLen" maxLength:"255"` /* canonical path to file or directory */
+	Operation uint32 /* NFS operation */
+	Status    uint32 /* NFS operation status, nfsstat4 */
+}
+
+func (f ExtendedNFSStorageTransactionFlow) String() string {
+	type X ExtendedNFSStorageTransactionFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedNFSStorageTransactionFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedNFSStorageTransactionFlow) RecordName() string {
+	return "ExtendedNFSStorageTransactionFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedNFSStorageTransactionFlow) RecordType() int {
+	return TypeExtendedNFSStorageTransactionFlowRecord
+}
+
+func (f ExtendedNFSStorageTransactionFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.PathLen)
+	size += len(f.Path) + xdrPadding(len(f.Path))
+	size += binary.Size(f.Operation)
+	size += binary.Size(f.Status)
+
+	return size
+}
+
+func (f ExtendedNFSStorageTransactionFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
+
+// ExtendedSCSIStorageTransactionFlow - TypeExtendedSCSIStorageTransactionFlowRecord
+type ExtendedSCSIStorageTransactionFlow struct {
+	LUN       uint32 /* LUN */
+	Operation uint32 /* SCSI operation, maxint if unknown */
+	Status    uint32 /* SCSI status code */
+}
+
+func (f ExtendedSCSIStorageTransactionFlow) String() string {
+	type X ExtendedSCSIStorageTransactionFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedSCSIStorageTransactionFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedSCSIStorageTransactionFlow) RecordName() string {
+	return "ExtendedSCSIStorageTransactionFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedSCSIStorageTransactionFlow) RecordType() int {
+	return TypeExtendedSCSIStorageTransactionFlowRecord
+}
+
+func (f ExtendedSCSIStorageTransactionFlow) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f ExtendedSCSIStorageTransactionFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// ExtendedHTTPTransactionFlow - TypeExtendedHTTPTransactionFlowRecord
+type ExtendedHTTPTransactionFlow struct {
+	URLLen       uint32
+	URL          []byte `lengthLookUp:"URLLen"` /* the URL */
+	HostLen      uint32
+	Host         []byte `lengthLookUp:"HostLen"` /* the host */
+	RefererLen   uint32
+	Referer      []byte `lengthLookUp:"RefererLen"` /* the referer */
+	UseragentLen uint32
+	Useragent    []byte `lengthLookUp:"UseragentLen"` /* the user agent */
+	UserLen      uint32
+	User         []byte `lengthLookUp:"UserLen"` /* the user */
+	Status       uint32 /* HTTP status code */
+}
+
+func (f ExtendedHTTPTransactionFlow) String() string {
+	type X ExtendedHTTPTransactionFlow
+	x := X(f)
+	return fmt.Sprintf("ExtendedHTTPTransactionFlow: %+v", x)
+}
+
+// RecordName returns the Name of this flow record
+func (f ExtendedHTTPTransactionFlow) RecordName() string {
+	return "ExtendedHTTPTransactionFlow"
+}
+
+// RecordType returns the ID of the sflow flow record
+func (f ExtendedHTTPTransactionFlow) RecordType() int {
+	return TypeExtendedHTTPTransactionFlowRecord
+}
+
+func (f ExtendedHTTPTransactionFlow) calculateBinarySize() int {
+	var size int
+
+	size += binary.Size(f.URLLen)
+	size += len(f.URL) + xdrPadding(len(f.URL))
+	size += binary.Size(f.HostLen)
+	size += len(f.Host) + xdrPadding(len(f.Host))
+	size += binary.Size(f.RefererLen)
+	size += len(f.Referer) + xdrPadding(len(f.Referer))
+	size += binary.Size(f.UseragentLen)
+	size += len(f.Useragent) + xdrPadding(len(f.Useragent))
+	size += binary.Size(f.UserLen)
+	size += len(f.User) + xdrPadding(len(f.User))
+	size += binary.Size(f.Status)
+
+	return size
+}
+
+func (f ExtendedHTTPTransactionFlow) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return Encode(w, f)
+}
@@ -0,0 +1,75 @@
+package records
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDecodeTransactionFlowRecords(t *testing.T) {
+	recs := []Record{
+		TransactionFlow{
+			Direction:     TransactionDirectionServer,
+			Wait:          20,
+			Duration:      4000,
+			Status:        TransactionStatusSucceeded,
+			BytesReceived: 420,
+			BytesSend:     1 << 20,
+		},
+		ExtendedNFSStorageTransactionFlow{
+			PathLen:   12,
+			Path:      []byte("/export/home"),
+			Operation: 25, // OP_READ
+			Status:    0,
+		},
+		ExtendedSCSIStorageTransactionFlow{LUN: 3, Operation: 0x28, Status: 0},
+		ExtendedHTTPTransactionFlow{
+			URLLen:       11,
+			URL:          []byte("/index.html"),
+			HostLen:      11,
+			Host:         []byte("example.com"),
+			UseragentLen: 10,
+			Useragent:    []byte("curl/8.5.0"),
+			Status:       200,
+		},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+}
+
+func TestDecodeTransactionFlowOversizedLength(t *testing.T) {
+	tests := map[uint32][]uint32{
+		TypeExtendedNFSStorageTransactionFlowRecord: {0xfffffff0, 0x2f657870, 25, 0},
+		TypeExtendedHTTPTransactionFlowRecord:       {1, 0x2f000000, 0xfffffff0, 0, 0, 0, 200},
+	}
+
+	for recordType, data := range tests {
+		b := &bytes.Buffer{}
+		binary.Write(b, binary.BigEndian, data)
+
+		if _, err := DecodeFlow(b, recordType); err == nil {
+			t.Errorf("%d: expected an error decoding a length larger than the record", recordType)
+		}
+	}
+}
+
+func TestExtendedNFSStorageTransactionFlowPathMaxLength(t *testing.T) {
+	path := "/" + strings.Repeat("a", 255)
+	rec := ExtendedNFSStorageTransactionFlow{PathLen: uint32(len(path)), Path: []byte(path)}
+
+	if err := rec.Encode(&bytes.Buffer{}); err == nil {
+		t.Error("expected an error encoding a path longer than 255 bytes")
+	}
+
+	b := &bytes.Buffer{}
+	binary.Write(b, binary.BigEndian, uint32(len(path)))
+	b.WriteString(path)
+	b.Write(make([]byte, 16))
+
+	if _, err := DecodeFlow(b, TypeExtendedNFSStorageTransactionFlowRecord); err == nil {
+		t.Error("expected an error decoding a path longer than 255 bytes")
+	}
+}
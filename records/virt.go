@@ -0,0 +1,278 @@
+package records
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// VirtDomainState is the state of a virtual domain as reported in VirtCPUCounter, see libvirt's virDomainState
+type VirtDomainState uint32
+
+// Virtual domain states
+const (
+	VirtDomainStateNoState     VirtDomainState = 0 // no state
+	VirtDomainStateRunning     VirtDomainState = 1 // the domain is running
+	VirtDomainStateBlocked     VirtDomainState = 2 // the domain is blocked on resource
+	VirtDomainStatePaused      VirtDomainState = 3 // the domain is paused by user
+	VirtDomainStateShutdown    VirtDomainState = 4 // the domain is being shut down
+	VirtDomainStateShutoff     VirtDomainState = 5 // the domain is shut off
+	VirtDomainStateCrashed     VirtDomainState = 6 // the domain is crashed
+	VirtDomainStatePMSuspended VirtDomainState = 7 // the domain is suspended by guest power management
+)
+
+var virtDomainStateNames = map[VirtDomainState]string{
+	VirtDomainStateNoState:     "nostate",
+	VirtDomainStateRunning:     "running",
+	VirtDomainStateBlocked:     "blocked",
+	VirtDomainStatePaused:      "paused",
+	VirtDomainStateShutdown:    "shutdown",
+	VirtDomainStateShutoff:     "shutoff",
+	VirtDomainStateCrashed:     "crashed",
+	VirtDomainStatePMSuspended: "pmsuspended",
+}
+
+// String returns the name of the domain state.
+func (s VirtDomainState) String() string {
+	if name, found := virtDomainStateNames[s]; found {
+		return name
+	}
+
+	return fmt.Sprintf("VirtDomainState(%d)", uint32(s))
+}
+
+// VirtNodeCounter - TypeVirtNodeCounterRecord
+type VirtNodeCounter struct {
+	MHz        uint32 /* expected CPU frequency */
+	CPUs       uint32 /* the number of active CPUs */
+	Memory     uint64 /* memory size in bytes */
+	MemoryFree uint64 /* unassigned memory in bytes */
+	NumDomains uint32 /* number of active domains */
+}
+
+func (f VirtNodeCounter) String() string {
+	type X VirtNodeCounter
+	x := X(f)
+	return fmt.Sprintf("VirtNodeCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f VirtNodeCounter) RecordName() string {
+	return "VirtNodeCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f VirtNodeCounter) RecordType() int {
+	return TypeVirtNodeCounterRecord
+}
+
+func (f VirtNodeCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f VirtNodeCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// VirtCPUCounter - TypeVirtCPUCounterRecord
+type VirtCPUCounter struct {
+	State     VirtDomainState /* the domain state */
+	CPUTime   uint32          /* the CPU time used in ms */
+	NrVirtCPU uint32          /* number of virtual CPUs for the domain */
+}
+
+func (f VirtCPUCounter) String() string {
+	type X VirtCPUCounter
+	x := X(f)
+	return fmt.Sprintf("VirtCPUCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f VirtCPUCounter) RecordName() string {
+	return "VirtCPUCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f VirtCPUCounter) RecordType() int {
+	return TypeVirtCPUCounterRecord
+}
+
+func (f VirtCPUCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f VirtCPUCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// VirtMemoryCounter - TypeVirtMemoryCounterRecord
+type VirtMemoryCounter struct {
+	Memory    uint64 /* memory in bytes used by domain */
+	MaxMemory uint64 /* memory in bytes allowed */
+}
+
+func (f VirtMemoryCounter) String() string {
+	type X VirtMemoryCounter
+	x := X(f)
+	return fmt.Sprintf("VirtMemoryCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f VirtMemoryCounter) RecordName() string {
+	return "VirtMemoryCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f VirtMemoryCounter) RecordType() int {
+	return TypeVirtMemoryCounterRecord
+}
+
+func (f VirtMemoryCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f VirtMemoryCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// VirtDiskIOCounter - TypeVirtDiskIOCounterRecord
+type VirtDiskIOCounter struct {
+	Capacity   uint64 /* logical size in bytes */
+	Allocation uint64 /* current allocation in bytes */
+	Available  uint64 /* remaining free bytes */
+	RdReq      uint32 /* number of read requests */
+	RdBytes    uint64 /* number of read bytes */
+	WrReq      uint32 /* number of write requests */
+	WrBytes    uint64 /* number of written bytes */
+	Errs       uint32 /* read/write errors */
+}
+
+func (f VirtDiskIOCounter) String() string {
+	type X VirtDiskIOCounter
+	x := X(f)
+	return fmt.Sprintf("VirtDiskIOCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f VirtDiskIOCounter) RecordName() string {
+	return "VirtDiskIOCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f VirtDiskIOCounter) RecordType() int {
+	return TypeVirtDiskIOCounterRecord
+}
+
+func (f VirtDiskIOCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f VirtDiskIOCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
+
+// VirtNetIOCounter - TypeVirtNetIOCounterRecord
+type VirtNetIOCounter struct {
+	RxBytes   uint64 /* total bytes received */
+	RxPackets uint32 /* total packets received */
+	RxErrs    uint32 /* total receive errors */
+	RxDrop    uint32 /* total receive drops */
+	TxBytes   uint64 /* total bytes transmitted */
+	TxPackets uint32 /* total packets transmitted */
+	TxErrs    uint32 /* total transmit errors */
+	TxDrop    uint32 /* total transmit drops */
+}
+
+func (f VirtNetIOCounter) String() string {
+	type X VirtNetIOCounter
+	x := X(f)
+	return fmt.Sprintf("VirtNetIOCounter: %+v", x)
+}
+
+// RecordName returns the Name of this counter record
+func (f VirtNetIOCounter) RecordName() string {
+	return "VirtNetIOCounter"
+}
+
+// RecordType returns the ID of the sflow counter record
+func (f VirtNetIOCounter) RecordType() int {
+	return TypeVirtNetIOCounterRecord
+}
+
+func (f VirtNetIOCounter) calculateBinarySize() int {
+	return binary.Size(f)
+}
+
+func (f VirtNetIOCounter) Encode(w io.Writer) error {
+	var err error
+
+	err = binary.Write(w, binary.BigEndian, uint32(f.RecordType()))
+	if err != nil {
+		return err
+	}
+
+	encodedRecordLength := f.calculateBinarySize()
+
+	err = binary.Write(w, binary.BigEndian, uint32(encodedRecordLength))
+	if err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, f)
+}
@@ -0,0 +1,49 @@
+package records
+
+import (
+	"testing"
+)
+
+func TestEncodeDecodeVirtCounterRecords(t *testing.T) {
+	recs := []Record{
+		VirtNodeCounter{MHz: 2400, CPUs: 16, Memory: 64 << 30, MemoryFree: 8 << 30, NumDomains: 5},
+		VirtCPUCounter{State: VirtDomainStateRunning, CPUTime: 123456, NrVirtCPU: 4},
+		VirtMemoryCounter{Memory: 3 << 30, MaxMemory: 4 << 30},
+		VirtDiskIOCounter{Capacity: 100 << 30, Allocation: 40 << 30, Available: 60 << 30, RdReq: 1000, RdBytes: 1 << 32, WrReq: 500, WrBytes: 1 << 31, Errs: 1},
+		VirtNetIOCounter{RxBytes: 1 << 33, RxPackets: 100000, RxErrs: 2, RxDrop: 3, TxBytes: 1 << 32, TxPackets: 90000, TxErrs: 1, TxDrop: 4},
+	}
+
+	for _, rec := range recs {
+		roundTrip(t, rec)
+	}
+}
+
+func TestCalculateBinarySizeVirtCounters(t *testing.T) {
+	// Sizes as given by the sFlow Host Structures specification
+	sizes := map[string]int{
+		VirtNodeCounter{}.RecordName():   28,
+		VirtCPUCounter{}.RecordName():    12,
+		VirtMemoryCounter{}.RecordName(): 16,
+		VirtDiskIOCounter{}.RecordName(): 52,
+		VirtNetIOCounter{}.RecordName():  40,
+	}
+
+	recs := []interface {
+		Record
+		calculateBinarySize() int
+	}{VirtNodeCounter{}, VirtCPUCounter{}, VirtMemoryCounter{}, VirtDiskIOCounter{}, VirtNetIOCounter{}}
+
+	for _, rec := range recs {
+		if size := rec.calculateBinarySize(); size != sizes[rec.RecordName()] {
+			t.Errorf("%s: expected %d bytes, got %d", rec.RecordName(), sizes[rec.RecordName()], size)
+		}
+	}
+
+	if VirtDomainStateCrashed.String() != "crashed" {
+		t.Errorf("expected crashed, got %s", VirtDomainStateCrashed)
+	}
+
+	if VirtDomainState(7).String() != "pmsuspended" {
+		t.Errorf("expected pmsuspended, got %s", VirtDomainState(7))
+	}
+}
@@ -12,6 +12,7 @@ const (
 	TypeCounterSample         = 2
 	TypeExpandedFlowSample    = 3
 	TypeExpandedCounterSample = 4
+	TypeDiscardedPacketSample = 5
 )
 
 var (
@@ -44,6 +45,15 @@ func decodeSample(r io.ReadSeeker) (Sample, error) {
 	case TypeFlowSample:
 		return decodeFlowSample(r)
 
+	case TypeExpandedFlowSample:
+		return decodeExpandedFlowSample(r)
+
+	case TypeExpandedCounterSample:
+		return decodeExpandedCounterSample(r)
+
+	case TypeDiscardedPacketSample:
+		return decodeDiscardedPacketSample(r)
+
 	default:
 		_, err = r.Seek(int64(length), 1)
 		if err != nil {